@@ -0,0 +1,257 @@
+package tsratecalc
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultAutoRadiusMarginPercent is the default safety margin of AutoRadius, in percent of the largest |rate|.
+const DefaultAutoRadiusMarginPercent = 10
+
+var ErrAutoRadiusRatesEmpty = errors.New("auto radius requires at least one rate")
+
+// AutoRadius derives the Config.ConvergenceRadius from the expected rates domain, instead of guessing it.
+type AutoRadius[Decimal Operator[Decimal]] struct {
+	// Rates are the expected rates: the domain boundaries (e.g. the min and max rates), or a sample of historical rates.
+	// The convergence radius is the largest |rate|, plus the safety margin. The margin is reduced if the radius wouldn't
+	// fit in Config.MaxTermsCache, e.g. for rates near 1, down to the largest radius that fits (see EstimateTerms).
+	// MultiRootCalculator configs have no root to predict the terms, so their margin isn't reduced.
+	Rates []Decimal
+	// MarginPercent is the safety margin added to the largest |rate|, in percent.
+	// If not provided, DefaultAutoRadiusMarginPercent will be used.
+	MarginPercent uint64
+	// Expand enables the expansion of the convergence boundaries when a rate outside them is computed,
+	// instead of returning ErrRateOutsideConvergenceBoundaries. The new radius is the rate absolute value, plus the safety margin.
+	//
+	// The Taylor terms required by the expanded boundaries are lazily computed, and the margin is reduced like the
+	// Rates one. The expansion fails with ErrRateOutsideConvergenceBoundaries (wrapping TermsLimitError) only if
+	// the rate absolute value itself wouldn't fit in Config.MaxTermsCache.
+	Expand bool
+}
+
+// radius returns the largest |rate| plus the safety margin, limited by capRadius.
+func (a *AutoRadius[Decimal]) radius(cfg Config[Decimal]) (Decimal, error) {
+	var zero Decimal
+
+	if len(a.Rates) == 0 {
+		return zero, ErrAutoRadiusRatesEmpty
+	}
+
+	var maxAbs Decimal
+
+	for i, rate := range a.Rates {
+		abs, err := rate.Abs()
+		if err != nil {
+			return zero, fmt.Errorf("computing rate %d absolute value: %w", i, err)
+		}
+
+		if i == 0 {
+			maxAbs = abs
+
+			continue
+		}
+
+		lower, err := abs.LessThanOrEqual(maxAbs)
+		if err != nil {
+			return zero, fmt.Errorf("comparing rate %d with the largest |rate|: %w", i, err)
+		}
+
+		if !lower {
+			maxAbs = abs
+		}
+	}
+
+	radius, err := addMargin(maxAbs, a.MarginPercent, cfg.Precision+cfg.GuardDigits, cfg.NewFromInt)
+	if err != nil {
+		return zero, err
+	}
+
+	return capRadius(cfg, radius, maxAbs)
+}
+
+// capRadius limits the radius to the largest one whose Taylor terms fit in Config.MaxTermsCache, so the safety margin
+// doesn't make a rate near 1 unusable. The radius is never lower than |rate|: if it doesn't fit either,
+// validateTermsLimit rejects it. Configs without a root (e.g. MultiRootCalculator) aren't capped.
+func capRadius[Decimal Operator[Decimal]](cfg Config[Decimal], radius, abs Decimal) (Decimal, error) {
+	if cfg.Root < minRoot {
+		return radius, nil
+	}
+
+	millionths, err := cfg.NewFromInt(maxRadiusMillionths(cfg, cfg.MaxTermsCache-1))
+	if err != nil {
+		return radius, fmt.Errorf("creating max radius numerator: %w", err)
+	}
+
+	scale, err := cfg.NewFromInt(radiusScale)
+	if err != nil {
+		return radius, fmt.Errorf("creating max radius denominator: %w", err)
+	}
+
+	maxRadius, err := millionths.DivRound(scale, 6)
+	if err != nil {
+		return radius, fmt.Errorf("computing max radius: %w", err)
+	}
+
+	fits, err := radius.LessThanOrEqual(maxRadius)
+	if err != nil {
+		return radius, fmt.Errorf("comparing radius with the max radius: %w", err)
+	}
+
+	if fits {
+		return radius, nil
+	}
+
+	belowRate, err := maxRadius.LessThanOrEqual(abs)
+	if err != nil {
+		return radius, fmt.Errorf("comparing max radius with |rate|: %w", err)
+	}
+
+	if belowRate {
+		return abs, nil
+	}
+
+	return maxRadius, nil
+}
+
+// addMargin returns "abs * (100 + marginPercent) / 100", rounded to the provided decimal places.
+func addMargin[Decimal Operator[Decimal]](abs Decimal, marginPercent, places uint64, newFromInt func(n uint64) (Decimal, error)) (Decimal, error) {
+	var zero Decimal
+
+	numerator, err := newFromInt(100 + marginPercent)
+	if err != nil {
+		return zero, fmt.Errorf("creating margin numerator: %w", err)
+	}
+
+	hundred, err := newFromInt(100)
+	if err != nil {
+		return zero, fmt.Errorf("creating '100' decimal: %w", err)
+	}
+
+	radius, err := abs.Mul(numerator)
+	if err != nil {
+		return zero, fmt.Errorf("multiplying |rate| by margin numerator: %w", err)
+	}
+
+	radius, err = radius.DivRound(hundred, places)
+	if err != nil {
+		return zero, fmt.Errorf("dividing |rate| by margin denominator: %w", err)
+	}
+
+	return radius, nil
+}
+
+// convergenceBoundaries are the current boundaries of an expandable Calculator.
+type convergenceBoundaries[Decimal Operator[Decimal]] struct {
+	lower Decimal
+	upper Decimal
+}
+
+// radiusExpansion expands the convergence boundaries of a Calculator on demand.
+//
+// The boundaries are published atomically, so readers never block, while expansions are serialized by a mutex.
+// The boundaries only grow.
+type radiusExpansion[Decimal Operator[Decimal]] struct {
+	// cfg is the validated Config, used to check if the expanded radius fits in the terms cache.
+	cfg Config[Decimal]
+
+	boundaries atomic.Pointer[convergenceBoundaries[Decimal]]
+
+	// mu serializes the expansions.
+	mu sync.Mutex
+}
+
+// newRadiusExpansion returns a radiusExpansion starting on the Config boundaries.
+func newRadiusExpansion[Decimal Operator[Decimal]](cfg Config[Decimal], lower, upper Decimal) *radiusExpansion[Decimal] {
+	e := &radiusExpansion[Decimal]{
+		cfg: cfg,
+	}
+
+	e.boundaries.Store(&convergenceBoundaries[Decimal]{
+		lower: lower,
+		upper: upper,
+	})
+
+	return e
+}
+
+// load returns the current boundaries. It never blocks.
+func (e *radiusExpansion[Decimal]) load() *convergenceBoundaries[Decimal] {
+	return e.boundaries.Load()
+}
+
+// expand grows the boundaries to include the provided rate, with the safety margin, and returns them.
+func (e *radiusExpansion[Decimal]) expand(rate, zero Decimal) (*convergenceBoundaries[Decimal], error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	abs, err := rate.Abs()
+	if err != nil {
+		return nil, fmt.Errorf("computing rate absolute value: %w", err)
+	}
+
+	radius, err := addMargin(abs, e.cfg.AutoRadius.MarginPercent, e.cfg.Precision+e.cfg.GuardDigits, e.cfg.NewFromInt)
+	if err != nil {
+		return nil, fmt.Errorf("computing expanded radius: %w", err)
+	}
+
+	radius, err = capRadius(e.cfg, radius, abs)
+	if err != nil {
+		return nil, fmt.Errorf("capping expanded radius: %w", err)
+	}
+
+	current := e.load()
+
+	// Another goroutine could have expanded the boundaries already.
+	smaller, err := radius.LessThanOrEqual(current.upper)
+	if err != nil {
+		return nil, fmt.Errorf("comparing expanded radius with upper convergence boundary: %w", err)
+	}
+
+	if smaller {
+		return current, nil
+	}
+
+	cfg := e.cfg
+	cfg.ConvergenceRadius = radius
+
+	err = validateTermsLimit(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("expanding convergence radius to '%s': %w", radius.String(), err)
+	}
+
+	lower, err := zero.Sub(radius)
+	if err != nil {
+		return nil, fmt.Errorf("getting expanded lower convergence boundary: %w", err)
+	}
+
+	expanded := &convergenceBoundaries[Decimal]{
+		lower: lower,
+		upper: radius,
+	}
+
+	e.boundaries.Store(expanded)
+
+	return expanded, nil
+}
+
+// clone returns a copy of the expansion, cloning the current boundaries and the Config decimals.
+func (e *radiusExpansion[Decimal]) clone() *radiusExpansion[Decimal] {
+	if e == nil {
+		return nil
+	}
+
+	cfg := e.cfg
+	cfg.ConvergenceRadius = cloneDecimal(cfg.ConvergenceRadius)
+
+	if cfg.AutoRadius != nil {
+		autoRadius := *cfg.AutoRadius
+		autoRadius.Rates = cloneDecimals(autoRadius.Rates)
+		cfg.AutoRadius = &autoRadius
+	}
+
+	current := e.load()
+
+	return newRadiusExpansion(cfg, cloneDecimal(current.lower), cloneDecimal(current.upper))
+}
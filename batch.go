@@ -0,0 +1,94 @@
+package tsratecalc
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// BatchOptions customizes the ComputeRates calls.
+type BatchOptions struct {
+	// Workers is the number of goroutines computing the rates in parallel.
+	// If not provided, runtime.GOMAXPROCS(0) will be used.
+	Workers int
+}
+
+// ComputeRates computes every rate like ComputeRate, in parallel, returning the results in the same order of the rates.
+//
+// The errors slice stores the error of each rate, in the same order, and it's nil if every rate succeeded.
+// A failed rate doesn't stop the others, and its result is zero.
+//
+// If the context is done before every rate is computed, the context error is returned,
+// and it's also set as the error of each rate not computed.
+func (c *Calculator[Decimal]) ComputeRates(ctx context.Context, rates []Decimal, opts BatchOptions) ([]Decimal, []error, error) {
+	results := make([]Decimal, len(rates))
+
+	var (
+		errs    []error
+		errsMu  sync.Mutex
+		setErrs = func(i int, err error) {
+			errsMu.Lock()
+			defer errsMu.Unlock()
+
+			if errs == nil {
+				errs = make([]error, len(rates))
+			}
+
+			errs[i] = err
+		}
+	)
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	workers = min(workers, len(rates))
+
+	// next is the index of the next rate to compute, shared by the workers.
+	var (
+		next atomic.Int64
+		// canceled reports if any rate was skipped due to the context.
+		canceled atomic.Bool
+		wg       sync.WaitGroup
+	)
+
+	for range workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(rates) {
+					return
+				}
+
+				if err := ctx.Err(); err != nil {
+					results[i] = c.zero
+					setErrs(i, err)
+					canceled.Store(true)
+
+					continue
+				}
+
+				res, err := c.ComputeRate(rates[i])
+				if err != nil {
+					setErrs(i, err)
+				}
+
+				results[i] = res
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if canceled.Load() {
+		return results, errs, ctx.Err()
+	}
+
+	return results, errs, nil
+}
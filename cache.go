@@ -1,17 +1,147 @@
 package tsratecalc
 
 import (
+	"context"
+	"errors"
 	"fmt"
 )
 
+var ErrConvergenceBoundaryDiverging = errors.New("convergence boundary is diverging")
+
+// termsGenerator computes the constant part of the Taylor series terms, one by one.
+// It keeps the auxiliary accumulators between calls, so the terms cache could be extended at any moment.
+type termsGenerator[Decimal Operator[Decimal]] struct {
+	root Decimal
+	one  Decimal
+	// places is the working precision: the number of decimal places of the terms and intermediate divisions.
+	places     uint64
+	newFromInt func(n uint64) (Decimal, error)
+
+	// n is the index of the last generated term.
+	n uint64
+	// derivativeTermAcc is the accumulated derivative product for the next term.
+	derivativeTermAcc Decimal
+	// factorialTermAcc is n!.
+	factorialTermAcc Decimal
+}
+
+func newTermsGenerator[Decimal Operator[Decimal]](
+	root Decimal,
+	places uint64,
+	newFromInt func(n uint64) (Decimal, error),
+) (*termsGenerator[Decimal], error) {
+	one, err := newFromInt(1)
+	if err != nil {
+		return nil, fmt.Errorf("creating '1' decimal: %w", err)
+	}
+
+	return &termsGenerator[Decimal]{
+		root:              root,
+		one:               one,
+		places:            places,
+		newFromInt:        newFromInt,
+		derivativeTermAcc: one,
+		factorialTermAcc:  one,
+	}, nil
+}
+
+// clone returns a copy of the generator, cloning every decimal.
+func (g *termsGenerator[Decimal]) clone() *termsGenerator[Decimal] {
+	return &termsGenerator[Decimal]{
+		root:              cloneDecimal(g.root),
+		one:               cloneDecimal(g.one),
+		places:            g.places,
+		newFromInt:        g.newFromInt,
+		n:                 g.n,
+		derivativeTermAcc: cloneDecimal(g.derivativeTermAcc),
+		factorialTermAcc:  cloneDecimal(g.factorialTermAcc),
+	}
+}
+
+// reset rewinds the generator to its initial state, before the first term.
+func (g *termsGenerator[Decimal]) reset() {
+	g.n = 0
+	g.derivativeTermAcc = g.one
+	g.factorialTermAcc = g.one
+}
+
+// next computes the next Taylor term, returning it with its index.
+func (g *termsGenerator[Decimal]) next() (uint64, Decimal, error) {
+	var zero Decimal
+
+	n := g.n + 1
+
+	nDecimal, err := g.newFromInt(n)
+	if err != nil {
+		return 0, zero, fmt.Errorf("creating '%d' decimal from integer: %w", n, err)
+	}
+
+	factorialTermAcc, err := g.factorialTermAcc.Mul(nDecimal)
+	if err != nil {
+		return 0, zero, newOperatorError("Mul", "computing factorial term", n, err)
+	}
+
+	derivativeTermAcc, err := g.derivativeTermAcc.DivRound(g.root, g.places)
+	if err != nil {
+		return 0, zero, newOperatorError("DivRound", "computing derivative term", n, err)
+	}
+
+	// derivativeTermAcc * (1 - n * root)
+	var nextDerivativeTermAcc Decimal
+	{
+		// n * root
+		v, err := nDecimal.Mul(g.root)
+		if err != nil {
+			return 0, zero, newOperatorError("Mul", "multiplying n by root", n, err)
+		}
+
+		// 1 - n * root
+		v, err = g.one.Sub(v)
+		if err != nil {
+			return 0, zero, newOperatorError("Sub", "computing 1 - n*root", n, err)
+		}
+
+		// derivativeTermAcc * (1 - n * root)
+		v, err = derivativeTermAcc.Mul(v)
+		if err != nil {
+			return 0, zero, newOperatorError("Mul", "multiplying derivative term by 1 - n*root", n, err)
+		}
+
+		nextDerivativeTermAcc = v
+	}
+
+	// derivativeTermAcc / n!
+
+	term, err := derivativeTermAcc.DivRound(factorialTermAcc, g.places)
+	if err != nil {
+		return 0, zero, newOperatorError("DivRound", "computing derivative term divided by factorial term", n, err)
+	}
+
+	truncatedTerm, err := term.Truncate(g.places)
+	if err != nil {
+		return 0, zero, newOperatorError("Truncate", "truncating taylor term", n, err)
+	}
+
+	g.n = n
+	g.factorialTermAcc = factorialTermAcc
+	g.derivativeTermAcc = nextDerivativeTermAcc
+
+	return n, truncatedTerm, nil
+}
+
 // computeTaylorTermsCache creates an in-memory cache of the constant part of the Taylor series terms.
 // It will compute all the "terms"-first terms for the provided day count convention.
+//
+// The context is checked before each term, returning BuildCanceledError if it's done. If progress isn't nil,
+// it's called after each term with its boundary errors.
 func computeTaylorTermsCache[Decimal Operator[Decimal]](
+	ctx context.Context,
+	progress func(p Progress[Decimal]),
 	root Decimal,
 	convergenceRadius Decimal,
 	maxTermsCache uint64,
 	maxError Decimal,
-	precision uint64,
+	places uint64,
 	newFromInt func(n uint64) (Decimal, error),
 ) ([]Decimal, error) {
 	zero, err := newFromInt(0)
@@ -31,103 +161,74 @@ func computeTaylorTermsCache[Decimal Operator[Decimal]](
 		return nil, fmt.Errorf("getting lower convergence boundary: %w", err)
 	}
 
+	generator, err := newTermsGenerator(root, places, newFromInt)
+	if err != nil {
+		return nil, fmt.Errorf("creating terms generator: %w", err)
+	}
+
 	var terms []Decimal
 
 	// Auxiliary accumulators
 	var (
-		nextDerivativeTermAcc       = zero
-		derivativeTermAcc           = one
-		factorialTermAcc            = one
 		lowerBoundVariableComponent = one
 		lastLowerBoundaryError      = zero
 		upperBoundVariableComponent = one
 		lastUpperBoundaryError      = zero
 	)
 
-	for n := uint64(1); n < maxTermsCache; n++ {
-		nDecimal, err := newFromInt(n)
-		if err != nil {
-			return nil, fmt.Errorf("creating '%d' decimal from integer: %w", n, err)
-		}
-
-		factorialTermAcc, err = factorialTermAcc.Mul(nDecimal)
-		if err != nil {
-			return nil, fmt.Errorf("computing factorial term: %w", err)
-		}
-
-		derivativeTermAcc, err = derivativeTermAcc.DivRound(root, precision+1)
-		if err != nil {
-			return nil, fmt.Errorf("computing derivative term: %w", err)
-		}
-
-		// derivativeTermAcc * (1 - n * root)
-		{
-			// n * root
-			v, err := nDecimal.Mul(root)
-			if err != nil {
-				return nil, fmt.Errorf("multiplying n by root: %w", err)
+	for generator.n+1 < maxTermsCache {
+		select {
+		case <-ctx.Done():
+			return nil, &BuildCanceledError[Decimal]{
+				Err:                context.Cause(ctx),
+				Terms:              generator.n,
+				LowerBoundaryError: lastLowerBoundaryError,
+				UpperBoundaryError: lastUpperBoundaryError,
 			}
-
-			// 1 - n * root
-			v, err = one.Sub(v)
-			if err != nil {
-				return nil, fmt.Errorf("computing 1 - n*root: %w", err)
-			}
-
-			// derivativeTermAcc * (1 - n * root)
-			v, err = derivativeTermAcc.Mul(v)
-			if err != nil {
-				return nil, fmt.Errorf("multiplying derivative term by 1 - n*root: %w", err)
-			}
-
-			nextDerivativeTermAcc = v
+		default:
 		}
 
-		// derivativeTermAcc / n!
-
-		term, err := derivativeTermAcc.DivRound(factorialTermAcc, precision+1)
+		n, truncatedTerm, err := generator.next()
 		if err != nil {
-			return nil, fmt.Errorf("computing derivative term divided by factorial term: %w", err)
-		}
-
-		truncatedTerm, err := term.Truncate(precision + 1)
-		if err != nil {
-			return nil, fmt.Errorf("truncating taylor term '%s': %w", term.String(), err)
+			return nil, err
 		}
 
 		terms = append(terms, truncatedTerm)
-		derivativeTermAcc = nextDerivativeTermAcc
 
 		// Checking the error on lower convergence boundary
 		{
 			// (lower bound rate)^n
 			lowerBoundVariableComponent, err = lowerBoundVariableComponent.Mul(lowerConvergenceBoundary)
 			if err != nil {
-				return nil, fmt.Errorf("computing lower convergence rate variable component x^%d: %w", n, err)
+				return nil, newOperatorError("Mul", "computing lower convergence rate variable component x^n", n, err)
 			}
 
 			// Multiplying term by variable component.
 			lowerBoundaryError, err := truncatedTerm.Mul(lowerBoundVariableComponent)
 			if err != nil {
-				return nil, fmt.Errorf("computing lower boundary error on iteration %d: %w", n, err)
+				return nil, newOperatorError("Mul", "computing lower boundary error", n, err)
 			}
 
 			lowerBoundaryError, err = lowerBoundaryError.Abs()
 			if err != nil {
-				return nil, fmt.Errorf("computing lower boundary absolute value error on iteration %d: %w", n, err)
+				return nil, newOperatorError("Abs", "computing lower boundary error absolute value", n, err)
 			}
 
 			// Should not check first iteration.
 			if n > 1 {
 				converging, err := lowerBoundaryError.LessThanOrEqual(lastLowerBoundaryError)
 				if err != nil {
-					return nil, fmt.Errorf("comparing lower boundary error with the last seen on iteration %d: %w", n, err)
+					return nil, newOperatorError("LessThanOrEqual", "comparing lower boundary error with the last seen", n, err)
 				}
 
 				if !converging {
-					return nil, fmt.Errorf("lower convergence boundary is diverging (%d taylor term): %w", n, err)
+					return nil, &DivergenceError[Decimal]{
+						Boundary:      lowerConvergenceBoundary,
+						Term:          n,
+						TermError:     lowerBoundaryError,
+						LastTermError: lastLowerBoundaryError,
+					}
 				}
-
 			}
 
 			lastLowerBoundaryError = lowerBoundaryError
@@ -138,35 +239,48 @@ func computeTaylorTermsCache[Decimal Operator[Decimal]](
 			// (upper bound rate)^n
 			upperBoundVariableComponent, err = upperBoundVariableComponent.Mul(upperConvergenceBoundary)
 			if err != nil {
-				return nil, fmt.Errorf("computing upper convergence rate variable component x^%d: %w", n, err)
+				return nil, newOperatorError("Mul", "computing upper convergence rate variable component x^n", n, err)
 			}
 
 			// Multiplying term by variable component.
 			upperBoundaryError, err := truncatedTerm.Mul(upperBoundVariableComponent)
 			if err != nil {
-				return nil, fmt.Errorf("computing upper boundary error on iteration %d: %w", n, err)
+				return nil, newOperatorError("Mul", "computing upper boundary error", n, err)
 			}
 
 			upperBoundaryError, err = upperBoundaryError.Abs()
 			if err != nil {
-				return nil, fmt.Errorf("computing upper boundary absolute value error on iteration %d: %w", n, err)
+				return nil, newOperatorError("Abs", "computing upper boundary error absolute value", n, err)
 			}
 
 			// Should not check first iteration.
 			if n > 1 {
 				converging, err := upperBoundaryError.LessThanOrEqual(lastUpperBoundaryError)
 				if err != nil {
-					return nil, fmt.Errorf("comparing upper boundary error with the last seen on iteration %d: %w", n, err)
+					return nil, newOperatorError("LessThanOrEqual", "comparing upper boundary error with the last seen", n, err)
 				}
 
 				if !converging {
-					return nil, fmt.Errorf("upper convergence boundary is diverging (%d taylor term): %w", n, err)
+					return nil, &DivergenceError[Decimal]{
+						Boundary:      upperConvergenceBoundary,
+						Term:          n,
+						TermError:     upperBoundaryError,
+						LastTermError: lastUpperBoundaryError,
+					}
 				}
 			}
 
 			lastUpperBoundaryError = upperBoundaryError
 		}
 
+		if progress != nil {
+			progress(Progress[Decimal]{
+				Term:               n,
+				LowerBoundaryError: lastLowerBoundaryError,
+				UpperBoundaryError: lastUpperBoundaryError,
+			})
+		}
+
 		if n == 1 {
 			continue
 		}
@@ -174,7 +288,7 @@ func computeTaylorTermsCache[Decimal Operator[Decimal]](
 		// Checking if the function should stop generating new terms by comparing it to lower boundary error.
 		shouldStop, err := lastLowerBoundaryError.LessThanOrEqual(maxError)
 		if err != nil {
-			return nil, fmt.Errorf("checking if lower boundary error is less than max error: %w", err)
+			return nil, newOperatorError("LessThanOrEqual", "checking if lower boundary error is less than max error", n, err)
 		}
 
 		if !shouldStop {
@@ -184,7 +298,7 @@ func computeTaylorTermsCache[Decimal Operator[Decimal]](
 		// Checking if the function should stop generating new terms by comparing to upper boundary error.
 		shouldStop, err = lastUpperBoundaryError.LessThanOrEqual(maxError)
 		if err != nil {
-			return nil, fmt.Errorf("checking if upper boundary error is less than max error: %w", err)
+			return nil, newOperatorError("LessThanOrEqual", "checking if upper boundary error is less than max error", n, err)
 		}
 
 		if !shouldStop {
@@ -197,6 +311,7 @@ func computeTaylorTermsCache[Decimal Operator[Decimal]](
 	return terms, nil
 }
 
+// computeMaxError returns 10^(-precision)/2.
 func computeMaxError[Decimal Operator[Decimal]](
 	precision uint64,
 	newFromInt func(n uint64) (Decimal, error),
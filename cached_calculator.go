@@ -0,0 +1,127 @@
+package tsratecalc
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+)
+
+var ErrCachedCalculatorCapacityPositive = errors.New("cached calculator capacity must be positive")
+
+// CachedCalculator memoizes the ComputeRate results of a Calculator, keyed by the rate String() representation.
+//
+// It keeps at most a fixed number of results, evicting the least recently used one. Errors aren't cached,
+// so a failed rate returns the same error as Calculator.ComputeRate on every call. Every call returns its own copy
+// of the memoized result, so it can be modified by the caller. It's safe for concurrent use.
+type CachedCalculator[Decimal Operator[Decimal]] struct {
+	calc *Calculator[Decimal]
+	// capacity is the maximum number of stored results.
+	capacity int
+
+	mu sync.Mutex
+	// lru stores the cachedRate entries, from the most to the least recently used.
+	lru *list.List
+	// items indexes the lru elements by rate String() representation.
+	items map[string]*list.Element
+
+	stats CacheStats
+}
+
+// cachedRate is a memoized ComputeRate result.
+type cachedRate[Decimal Operator[Decimal]] struct {
+	key    string
+	result Decimal
+}
+
+// CacheStats is a snapshot of the CachedCalculator counters.
+type CacheStats struct {
+	// Hits is the number of ComputeRate calls that returned a memoized result.
+	Hits uint64
+	// Misses is the number of ComputeRate calls that computed the result.
+	Misses uint64
+	// Evictions is the number of results removed to respect the capacity.
+	Evictions uint64
+	// Len is the number of results currently stored.
+	Len int
+}
+
+// NewCachedCalculator returns a CachedCalculator that stores at most capacity results of the provided Calculator.
+func NewCachedCalculator[Decimal Operator[Decimal]](calc *Calculator[Decimal], capacity int) (*CachedCalculator[Decimal], error) {
+	if capacity <= 0 {
+		return nil, ErrCachedCalculatorCapacityPositive
+	}
+
+	return &CachedCalculator[Decimal]{
+		calc:     calc,
+		capacity: capacity,
+		lru:      list.New(),
+		items:    make(map[string]*list.Element, capacity),
+	}, nil
+}
+
+// ComputeRate returns the memoized result for the rate, or computes it with Calculator.ComputeRate.
+// Concurrent calls with the same missing rate could compute it more than once.
+func (c *CachedCalculator[Decimal]) ComputeRate(rate Decimal) (Decimal, error) {
+	key := rate.String()
+
+	c.mu.Lock()
+
+	if elem, ok := c.items[key]; ok {
+		c.lru.MoveToFront(elem)
+		c.stats.Hits++
+
+		// Cloning, so the callers can't modify the memoized result.
+		res := cloneDecimal(elem.Value.(*cachedRate[Decimal]).result)
+
+		c.mu.Unlock()
+
+		return res, nil
+	}
+
+	c.stats.Misses++
+
+	c.mu.Unlock()
+
+	// Computing outside the lock, so slow rates don't block the hits.
+	res, err := c.calc.ComputeRate(rate)
+	if err != nil {
+		return res, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Another goroutine could have stored the same rate meanwhile.
+	if elem, ok := c.items[key]; ok {
+		c.lru.MoveToFront(elem)
+
+		return res, nil
+	}
+
+	c.items[key] = c.lru.PushFront(&cachedRate[Decimal]{key: key, result: cloneDecimal(res)})
+
+	if c.lru.Len() > c.capacity {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.items, oldest.Value.(*cachedRate[Decimal]).key)
+		c.stats.Evictions++
+	}
+
+	return res, nil
+}
+
+// Stats returns a snapshot of the CachedCalculator counters.
+func (c *CachedCalculator[Decimal]) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Len = c.lru.Len()
+
+	return stats
+}
+
+// Calculator returns the underlying Calculator.
+func (c *CachedCalculator[Decimal]) Calculator() *Calculator[Decimal] {
+	return c.calc
+}
@@ -1,18 +1,68 @@
 package tsratecalc
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
 
 // Calculator is a calculator for "(1+x)^(1/n)-1", with positive integer n.
 // It uses a Taylor series expansion around x=0 to compute the rate value.
 //
 // It could be used for any arbitrary/fixed precision decimal that implements the Operator interface.
+//
+// A Calculator is safe for concurrent use after NewCalculator returns: the computations only read its fields,
+// and the lazy Taylor terms cache growth is synchronized. It relies on the Operator contract of not modifying
+// receivers and arguments. For Decimal types whose values share mutable state, see Cloner and Calculator.Clone.
 type Calculator[Decimal Operator[Decimal]] struct {
+	calculatorBase[Decimal]
+
+	// root is the "n" in the formula: "(1+x)^(1/n)-1".
+	root uint64
+	// taylorTerms is an in-memory cache for the Taylor series terms constant multipliers.
+	taylorTerms *termsCache[Decimal]
+	// thresholds stores how many Taylor terms are required for each |rate| bucket. It's nil for lazy caches.
+	thresholds *termThresholds[Decimal]
+	// expansion expands the convergence boundaries on demand (see AutoRadius.Expand). It's nil if disabled.
+	expansion *radiusExpansion[Decimal]
+	// evaluation is the scheme used to evaluate the Taylor polynomial, when the number of terms is known.
+	evaluation Evaluation
+	// firstTermCoefficient is 1/root, used to find the magnitude of the results on SignificantDigits mode.
+	firstTermCoefficient Decimal
+	// fingerprint identifies the Config fields that define the Taylor terms cache content.
+	fingerprint configFingerprint
+	// newFromString creates a Decimal from its String() representation. It could be nil.
+	newFromString func(s string) (Decimal, error)
+	// newFromInt creates a Decimal from an integer.
+	newFromInt func(n uint64) (Decimal, error)
+	// verify enables the self-verification of every computed rate.
+	verify bool
+	// observer receives the Calculator events. It could be nil.
+	observer Observer
+	// logger receives the Calculator logs. It could be nil.
+	logger *slog.Logger
+	// logComputations enables the debug logs of every computation.
+	logComputations bool
+	// verifyTolerances stores the maximum difference between "(1+result)^root" and "1+rate", for rates lower than or equal to zero,
+	// indexed by precision. Its value is 2*root*10^(-precision).
+	verifyTolerances []Decimal
+}
+
+// calculatorBase stores the values that doesn't depend on the root, so they could be shared between calculators.
+type calculatorBase[Decimal Operator[Decimal]] struct {
 	// precision is the number of decimal places to consider in the calculations.
 	precision uint64
-	// maxError is the maximum value for the error on calculations. Its value is 2*10^(-(precision+1)).
+	// guardDigits is the number of extra decimal places used by the intermediate calculations.
+	guardDigits uint64
+	// maxError is the maximum value for the error on calculations. Its value is 10^(-(precision+guardDigits-1))/2.
 	maxError Decimal
-	// taylorTerms is an in-memory cache for the Taylor series terms constant multipliers.
-	taylorTerms []Decimal
+	// maxErrors stores the maxError for every number of decimal places a result could have, indexed by decimal places.
+	maxErrors []Decimal
+	// precisionMode defines how precision is interpreted.
+	precisionMode PrecisionMode
+	// powersOfTen stores 10^0, 10^-1, ..., used to find the magnitude of the results. It's nil on DecimalPlaces mode.
+	powersOfTen []Decimal
 	// zero store the zero value for the Decimal type.
 	zero Decimal
 	// one store the one value for the Decimal type.
@@ -26,47 +76,82 @@ type Calculator[Decimal Operator[Decimal]] struct {
 // NewCalculator returns a new Calculator given a Config for a specific Decimal type.
 // The Decimal type should implement the Operator interface.
 func NewCalculator[Decimal Operator[Decimal]](cfg Config[Decimal]) (*Calculator[Decimal], error) {
-	cfg, err := validateConfig(cfg)
+	return NewCalculatorContext(context.Background(), cfg)
+}
+
+// NewCalculatorContext is the same as NewCalculator, but the Taylor terms cache construction could be canceled by the context,
+// and customized by the provided options (e.g. WithProgress).
+//
+// If the context is done before the construction ends, BuildCanceledError is returned with partial diagnostics.
+func NewCalculatorContext[Decimal Operator[Decimal]](ctx context.Context, cfg Config[Decimal], opts ...Option[Decimal]) (*Calculator[Decimal], error) {
+	validCfg, err := validateConfig(cfg)
 	if err != nil {
+		if cfg.Logger != nil {
+			logRejectedConfig(ctx, cfg, err)
+		}
+
 		return nil, fmt.Errorf("validating config: %w", err)
 	}
 
-	root, err := cfg.NewFromInt(cfg.Root)
-	if err != nil {
-		return nil, fmt.Errorf("creating root decimal from integer %d: %w", cfg.Root, err)
-	}
+	cfg = validCfg
 
-	maxError, err := computeMaxError(cfg.Precision, cfg.NewFromInt)
+	base, err := newCalculatorBase(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("computing max error: %w", err)
+		return nil, err
 	}
 
-	taylorTerms, err := computeTaylorTermsCache(root, cfg.ConvergenceRadius, cfg.MaxTermsCache, maxError, cfg.Precision, cfg.NewFromInt)
-	if err != nil {
-		return nil, fmt.Errorf("computing taylor terms cache: %w", err)
+	return newCalculatorFromBase(ctx, cfg, base, newOptions(opts))
+}
+
+// newCalculatorBase computes the values shared by calculators with the same Config, except for the root.
+// The Config should be already validated.
+func newCalculatorBase[Decimal Operator[Decimal]](cfg Config[Decimal]) (calculatorBase[Decimal], error) {
+	maxPlaces := cfg.PrecisionMode.maxPlaces(cfg.Precision)
+
+	maxErrors := make([]Decimal, 0, maxPlaces+1)
+
+	for precision := uint64(0); precision <= maxPlaces; precision++ {
+		maxError, err := computeMaxError(precision+cfg.GuardDigits-1, cfg.NewFromInt)
+		if err != nil {
+			return calculatorBase[Decimal]{}, fmt.Errorf("computing max error for precision %d: %w", precision, err)
+		}
+
+		maxErrors = append(maxErrors, maxError)
 	}
 
 	zero, err := cfg.NewFromInt(0)
 	if err != nil {
-		return nil, fmt.Errorf("creating '0' decimal: %w", err)
+		return calculatorBase[Decimal]{}, fmt.Errorf("creating '0' decimal: %w", err)
 	}
 
 	one, err := cfg.NewFromInt(1)
 	if err != nil {
-		return nil, fmt.Errorf("creating '1' decimal: %w", err)
+		return calculatorBase[Decimal]{}, fmt.Errorf("creating '1' decimal: %w", err)
+	}
+
+	var powersOfTen []Decimal
+
+	if cfg.PrecisionMode == SignificantDigits {
+		powersOfTen, err = computeNegativePowersOfTen(significantDigitsMaxShift+1, cfg.NewFromInt)
+		if err != nil {
+			return calculatorBase[Decimal]{}, err
+		}
 	}
 
 	upperConvergenceBoundary := cfg.ConvergenceRadius
 
 	lowerConvergenceBoundary, err := zero.Sub(cfg.ConvergenceRadius)
 	if err != nil {
-		return nil, fmt.Errorf("getting lower convergence boundary: %w", err)
+		return calculatorBase[Decimal]{}, fmt.Errorf("getting lower convergence boundary: %w", err)
 	}
 
-	return &Calculator[Decimal]{
+	return calculatorBase[Decimal]{
 		precision:                cfg.Precision,
-		maxError:                 maxError,
-		taylorTerms:              taylorTerms,
+		guardDigits:              cfg.GuardDigits,
+		maxError:                 maxErrors[cfg.Precision],
+		maxErrors:                maxErrors,
+		precisionMode:            cfg.PrecisionMode,
+		powersOfTen:              powersOfTen,
 		zero:                     zero,
 		one:                      one,
 		convergenceUpperBoundary: upperConvergenceBoundary,
@@ -74,7 +159,252 @@ func NewCalculator[Decimal Operator[Decimal]](cfg Config[Decimal]) (*Calculator[
 	}, nil
 }
 
+// newCalculatorFromBase computes the root dependent values (e.g. the Taylor terms cache) and returns a new Calculator,
+// reporting the build to the Config.Observer and Config.Logger. The Config should be already validated.
+func newCalculatorFromBase[Decimal Operator[Decimal]](
+	ctx context.Context,
+	cfg Config[Decimal],
+	base calculatorBase[Decimal],
+	opts options[Decimal],
+) (*Calculator[Decimal], error) {
+	if cfg.Observer == nil && cfg.Logger == nil {
+		return buildCalculator(ctx, cfg, base, opts)
+	}
+
+	start := time.Now()
+
+	calc, err := buildCalculator(ctx, cfg, base, opts)
+
+	duration := time.Since(start)
+
+	if cfg.Observer != nil && err == nil {
+		cfg.Observer.OnBuild(duration, calc.TermsCacheLen())
+	}
+
+	if cfg.Logger != nil {
+		logBuild(ctx, cfg, calc, duration, err)
+	}
+
+	return calc, err
+}
+
+// buildCalculator implements newCalculatorFromBase, without reporting the build.
+func buildCalculator[Decimal Operator[Decimal]](
+	ctx context.Context,
+	cfg Config[Decimal],
+	base calculatorBase[Decimal],
+	opts options[Decimal],
+) (*Calculator[Decimal], error) {
+	root, err := cfg.NewFromInt(cfg.Root)
+	if err != nil {
+		return nil, fmt.Errorf("creating root decimal from integer %d: %w", cfg.Root, err)
+	}
+
+	// workingPrecision is the number of decimal places of the Taylor terms.
+	workingPrecision := cfg.Precision + cfg.GuardDigits
+
+	// firstTermCoefficient is 1/root, used to find the magnitude of the results on SignificantDigits mode.
+	var firstTermCoefficient Decimal
+
+	if cfg.PrecisionMode == SignificantDigits {
+		// The rounding error of each term is multiplied by |rate|^n, and the result magnitude is |rate|/root,
+		// so the terms require as many extra digits as the root has to keep the relative error.
+		workingPrecision += decimalDigits(cfg.Root)
+
+		firstTermCoefficient, err = base.one.DivRound(root, workingPrecision)
+		if err != nil {
+			return nil, fmt.Errorf("computing 1/root: %w", err)
+		}
+	}
+
+	// cacheMaxError is the max error on the convergence boundaries.
+	cacheMaxError, err := base.boundaryMaxError(firstTermCoefficient)
+	if err != nil {
+		return nil, err
+	}
+
+	expand := cfg.AutoRadius != nil && cfg.AutoRadius.Expand
+
+	var taylorTerms *termsCache[Decimal]
+
+	if cfg.LazyTermsCache || expand {
+		generator, err := newTermsGenerator(root, workingPrecision, cfg.NewFromInt)
+		if err != nil {
+			return nil, fmt.Errorf("creating taylor terms generator: %w", err)
+		}
+
+		taylorTerms = newLazyTermsCache(generator, cfg.MaxTermsCache)
+	}
+
+	if !cfg.LazyTermsCache {
+		terms, err := computeTaylorTermsCache(ctx, opts.progress, root, cfg.ConvergenceRadius, cfg.MaxTermsCache, cacheMaxError, workingPrecision, cfg.NewFromInt)
+		if err != nil {
+			return nil, fmt.Errorf("computing taylor terms cache: %w", err)
+		}
+
+		// Expandable calculators keep the generator, so the cache could grow beyond the initial boundaries.
+		if expand {
+			taylorTerms.replace(terms)
+		} else {
+			taylorTerms = newStaticTermsCache(terms)
+		}
+	}
+
+	var expansion *radiusExpansion[Decimal]
+
+	if expand {
+		expansion = newRadiusExpansion(cfg, base.convergenceLowerBoundary, base.convergenceUpperBoundary)
+	}
+
+	var verifyTolerances []Decimal
+
+	if cfg.Verify {
+		verifyTolerances = make([]Decimal, 0, len(base.maxErrors))
+
+		for precision := range base.maxErrors {
+			// The tolerance depends on the result precision, not on the working precision.
+			maxError, err := computeMaxError(uint64(precision), cfg.NewFromInt)
+			if err != nil {
+				return nil, fmt.Errorf("computing max error for precision %d: %w", precision, err)
+			}
+
+			verifyTolerance, err := computeVerifyTolerance(root, maxError, cfg.NewFromInt)
+			if err != nil {
+				return nil, fmt.Errorf("computing verification tolerance for precision %d: %w", precision, err)
+			}
+
+			verifyTolerances = append(verifyTolerances, verifyTolerance)
+		}
+	}
+
+	calc := &Calculator[Decimal]{
+		calculatorBase:       base,
+		root:                 cfg.Root,
+		taylorTerms:          taylorTerms,
+		expansion:            expansion,
+		evaluation:           cfg.Evaluation,
+		firstTermCoefficient: firstTermCoefficient,
+		fingerprint:          newConfigFingerprint(cfg),
+		newFromString:        cfg.NewFromString,
+		newFromInt:           cfg.NewFromInt,
+		verify:               cfg.Verify,
+		verifyTolerances:     verifyTolerances,
+		observer:             cfg.Observer,
+		logger:               cfg.Logger,
+		logComputations:      cfg.LogComputations,
+	}
+
+	if !cfg.LazyTermsCache {
+		err = calc.computeThresholds()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return calc, nil
+}
+
+// boundaryMaxError returns the max error of the results on the convergence boundaries.
+// On SignificantDigits mode, it depends on the magnitude of the first Taylor term, given by firstTermCoefficient.
+func (b calculatorBase[Decimal]) boundaryMaxError(firstTermCoefficient Decimal) (Decimal, error) {
+	if b.precisionMode != SignificantDigits {
+		return b.maxError, nil
+	}
+
+	var zero Decimal
+
+	boundaryResult, err := firstTermCoefficient.Mul(b.convergenceUpperBoundary)
+	if err != nil {
+		return zero, fmt.Errorf("computing first term on convergence boundary: %w", err)
+	}
+
+	boundaryPlaces, err := significantPlaces(boundaryResult, b.precision, b.powersOfTen)
+	if err != nil {
+		return zero, err
+	}
+
+	return b.maxErrors[boundaryPlaces], nil
+}
+
+// computeThresholds computes the number of Taylor terms required for each |rate| bucket, from the current terms cache.
+// The thresholds are only computed on DecimalPlaces mode, since they depend on a fixed max error.
+func (c *Calculator[Decimal]) computeThresholds() error {
+	if c.precisionMode != DecimalPlaces {
+		return nil
+	}
+
+	thresholds, err := computeTermThresholds(c.convergenceUpperBoundary, c.taylorTerms.load(), c.maxError, c.precision+c.guardDigits, c.newFromInt)
+	if err != nil {
+		return fmt.Errorf("computing taylor terms thresholds: %w", err)
+	}
+
+	c.thresholds = thresholds
+
+	return nil
+}
+
 // TermsCacheLen returns the number of Taylor terms stored in the calculator's cache.
+// For lazy caches (see Config.LazyTermsCache), it's the number of terms computed so far.
 func (c *Calculator[Decimal]) TermsCacheLen() int {
-	return len(c.taylorTerms)
+	return len(c.taylorTerms.load())
+}
+
+// ConvergenceRadius returns the current convergence radius.
+// It's the derived radius for AutoRadius Configs, and grows with each expansion (see AutoRadius.Expand).
+func (c *Calculator[Decimal]) ConvergenceRadius() Decimal {
+	if c.expansion != nil {
+		return c.expansion.load().upper
+	}
+
+	return c.convergenceUpperBoundary
+}
+
+// Clone returns a Calculator with the same configuration and a copy of the current Taylor terms cache.
+//
+// If the Decimal type implements Cloner, every cached decimal is cloned, so the returned Calculator doesn't share
+// any mutable state with the original one. It's meant for Decimal types that can't be used concurrently:
+// each goroutine should use its own Clone. For other types, the decimals are shared and Clone is cheap,
+// but not required for concurrent use.
+func (c *Calculator[Decimal]) Clone() *Calculator[Decimal] {
+	clone := &Calculator[Decimal]{
+		calculatorBase:   c.calculatorBase.clone(),
+		root:             c.root,
+		taylorTerms:      c.taylorTerms.clone(),
+		thresholds:       c.thresholds.clone(),
+		expansion:        c.expansion.clone(),
+		evaluation:       c.evaluation,
+		fingerprint:      c.fingerprint,
+		newFromString:    c.newFromString,
+		newFromInt:       c.newFromInt,
+		verify:           c.verify,
+		verifyTolerances: cloneDecimals(c.verifyTolerances),
+		observer:         c.observer,
+		logger:           c.logger,
+		logComputations:  c.logComputations,
+	}
+
+	// The zero value of some Decimal types can't be cloned, so the coefficient is only cloned when it's set.
+	if c.precisionMode == SignificantDigits {
+		clone.firstTermCoefficient = cloneDecimal(c.firstTermCoefficient)
+	}
+
+	return clone
+}
+
+// clone returns a copy of the calculatorBase, cloning every decimal.
+func (b calculatorBase[Decimal]) clone() calculatorBase[Decimal] {
+	maxErrors := cloneDecimals(b.maxErrors)
+
+	return calculatorBase[Decimal]{
+		precision:                b.precision,
+		guardDigits:              b.guardDigits,
+		maxError:                 maxErrors[b.precision],
+		maxErrors:                maxErrors,
+		precisionMode:            b.precisionMode,
+		powersOfTen:              cloneDecimals(b.powersOfTen),
+		zero:                     cloneDecimal(b.zero),
+		one:                      cloneDecimal(b.one),
+		convergenceUpperBoundary: cloneDecimal(b.convergenceUpperBoundary),
+		convergenceLowerBoundary: cloneDecimal(b.convergenceLowerBoundary),
+	}
 }
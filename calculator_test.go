@@ -0,0 +1,235 @@
+package tsratecalc_test
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/mqzabin/tsratecalc"
+)
+
+// scratchDecimal is a big.Rat based decimal that shares a scratch buffer with every value derived from it,
+// like adapters for libraries with a mutable context. It's not safe for concurrent use without Clone.
+type scratchDecimal struct {
+	r       *big.Rat
+	scratch *big.Rat
+}
+
+var (
+	_ tsratecalc.Operator[scratchDecimal] = scratchDecimal{}
+	_ tsratecalc.Cloner[scratchDecimal]   = scratchDecimal{}
+)
+
+func newScratchFromInt(n uint64) (scratchDecimal, error) {
+	return scratchDecimal{
+		r:       new(big.Rat).SetUint64(n),
+		scratch: new(big.Rat),
+	}, nil
+}
+
+func newScratchFromString(s string) (scratchDecimal, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return scratchDecimal{}, fmt.Errorf("invalid decimal '%s'", s)
+	}
+
+	return scratchDecimal{
+		r:       r,
+		scratch: new(big.Rat),
+	}, nil
+}
+
+// result returns a new value, computed on the shared scratch buffer.
+func (d scratchDecimal) result(compute func(scratch *big.Rat)) scratchDecimal {
+	compute(d.scratch)
+
+	return scratchDecimal{
+		r:       new(big.Rat).Set(d.scratch),
+		scratch: d.scratch,
+	}
+}
+
+func (d scratchDecimal) Clone() scratchDecimal {
+	return scratchDecimal{
+		r:       new(big.Rat).Set(d.r),
+		scratch: new(big.Rat),
+	}
+}
+
+func (d scratchDecimal) Mul(n scratchDecimal) (scratchDecimal, error) {
+	return d.result(func(s *big.Rat) { s.Mul(d.r, n.r) }), nil
+}
+
+func (d scratchDecimal) DivRound(n scratchDecimal, places uint64) (scratchDecimal, error) {
+	if n.r.Sign() == 0 {
+		return scratchDecimal{}, fmt.Errorf("division by zero")
+	}
+
+	return d.result(func(s *big.Rat) {
+		s.Quo(d.r, n.r)
+		roundRat(s, places, true)
+	}), nil
+}
+
+func (d scratchDecimal) Sub(n scratchDecimal) (scratchDecimal, error) {
+	return d.result(func(s *big.Rat) { s.Sub(d.r, n.r) }), nil
+}
+
+func (d scratchDecimal) Add(n scratchDecimal) (scratchDecimal, error) {
+	return d.result(func(s *big.Rat) { s.Add(d.r, n.r) }), nil
+}
+
+func (d scratchDecimal) Abs() (scratchDecimal, error) {
+	return d.result(func(s *big.Rat) { s.Abs(d.r) }), nil
+}
+
+func (d scratchDecimal) LessThanOrEqual(n scratchDecimal) (bool, error) {
+	return d.r.Cmp(n.r) <= 0, nil
+}
+
+func (d scratchDecimal) PowInt(n uint64) (scratchDecimal, error) {
+	return d.result(func(s *big.Rat) {
+		s.SetInt64(1)
+
+		for range n {
+			s.Mul(s, d.r)
+		}
+	}), nil
+}
+
+func (d scratchDecimal) Truncate(places uint64) (scratchDecimal, error) {
+	return d.result(func(s *big.Rat) {
+		s.Set(d.r)
+		roundRat(s, places, false)
+	}), nil
+}
+
+func (d scratchDecimal) String() string {
+	return d.r.RatString()
+}
+
+// roundRat rounds r in place to the provided number of decimal places, half away from zero, or truncates it toward zero.
+func roundRat(r *big.Rat, places uint64, halfAwayFromZero bool) {
+	scale := new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(places), nil)
+
+	num := new(big.Int).Mul(r.Num(), scale)
+	if halfAwayFromZero {
+		// Adding half of the denominator before the truncation, keeping the sign.
+		half := new(big.Int).Quo(r.Denom(), big.NewInt(2))
+		if num.Sign() < 0 {
+			half.Neg(half)
+		}
+
+		num.Add(num, half)
+	}
+
+	r.SetFrac(num.Quo(num, r.Denom()), scale)
+}
+
+func TestCalculator_Clone_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	const goroutines = 8
+
+	radius, err := newScratchFromString("0.5")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	testCases := []struct {
+		name   string
+		lazy   bool
+		expand bool
+	}{
+		{name: "eager"},
+		{name: "lazy", lazy: true},
+		// The expandable calculators start with a smaller radius, so the clones expand their boundaries concurrently.
+		{name: "expand", lazy: true, expand: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := tsratecalc.Config[scratchDecimal]{
+				Root:              252,
+				Precision:         16,
+				NewFromInt:        newScratchFromInt,
+				NewFromString:     newScratchFromString,
+				ConvergenceRadius: radius.Clone(),
+				LazyTermsCache:    tc.lazy,
+			}
+
+			if tc.expand {
+				rate, err := newScratchFromString("0.1")
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				cfg.AutoRadius = &tsratecalc.AutoRadius[scratchDecimal]{
+					Rates:  []scratchDecimal{rate},
+					Expand: true,
+				}
+			}
+
+			calc, err := tsratecalc.NewCalculator(cfg)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			rates := []string{"0", "0.01", "-0.01", "0.1", "-0.1", "0.1375", "0.45", "-0.45"}
+
+			want := make([]string, len(rates))
+
+			for i, rate := range rates {
+				r, err := newScratchFromString(rate)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				res, err := calc.Clone().ComputeRate(r)
+				if err != nil {
+					t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+				}
+
+				want[i] = res.String()
+			}
+
+			var wg sync.WaitGroup
+
+			for range goroutines {
+				wg.Add(1)
+
+				// Each goroutine uses its own Clone, since scratchDecimal values share a scratch buffer.
+				clone := calc.Clone()
+
+				go func() {
+					defer wg.Done()
+
+					for i, rate := range rates {
+						r, err := newScratchFromString(rate)
+						if err != nil {
+							t.Errorf("unexpected error: %s", err.Error())
+
+							return
+						}
+
+						got, err := clone.ComputeRate(r)
+						if err != nil {
+							t.Errorf("unexpected error for rate '%s': %s", rate, err.Error())
+
+							return
+						}
+
+						if got.String() != want[i] {
+							t.Errorf("unexpected result for rate '%s': got '%s', want '%s'", rate, got.String(), want[i])
+						}
+					}
+				}()
+			}
+
+			wg.Wait()
+		})
+	}
+}
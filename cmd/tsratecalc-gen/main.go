@@ -0,0 +1,238 @@
+// Command tsratecalc-gen generates a Go source file with a precomputed Taylor terms table for a fixed Config,
+// and a constructor that builds a "github.com/mqzabin/tsratecalc/shopspring".Calculator without computing the terms at runtime.
+//
+// It's meant to be used with go generate:
+//
+//	//go:generate go run github.com/mqzabin/tsratecalc/cmd/tsratecalc-gen -root 252 -precision 30 -radius 0.9 -package tables -name Business252 -o business252.go
+//
+// The output only depends on the flags, so it's reproducible byte for byte.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+
+	shopspringdecimal "github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+var (
+	errPackageRequired = errors.New("package name is required")
+	errNameInvalid     = errors.New("name should be an exported Go identifier")
+	errRadiusRequired  = errors.New("convergence radius is required")
+)
+
+// options are the generator command line flags.
+type options struct {
+	root          int
+	precision     int
+	radius        string
+	maxTermsCache int
+	guardDigits   int
+	autoGuard     bool
+	pkg           string
+	name          string
+	output        string
+}
+
+func main() {
+	var opts options
+
+	flag.IntVar(&opts.root, "root", 252, "root value, i.e. the \"n\" in \"(1+x)^(1/n)-1\"")
+	flag.IntVar(&opts.precision, "precision", 30, "number of decimal places")
+	flag.StringVar(&opts.radius, "radius", "0.9", "convergence radius")
+	flag.IntVar(&opts.maxTermsCache, "max-terms", 0, "maximum number of Taylor terms, 0 uses the default")
+	flag.IntVar(&opts.guardDigits, "guard-digits", 0, "number of guard digits, 0 uses a single one")
+	flag.BoolVar(&opts.autoGuard, "auto-guard-digits", false, "derive the number of guard digits from the maximum number of Taylor terms")
+	flag.StringVar(&opts.pkg, "package", "", "package name of the generated file")
+	flag.StringVar(&opts.name, "name", "", "exported name used by the generated constructor (e.g. \"Business252\" generates \"NewBusiness252\")")
+	flag.StringVar(&opts.output, "o", "", "output file, defaults to stdout")
+	flag.Parse()
+
+	err := run(opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "tsratecalc-gen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(opts options) error {
+	src, err := generate(opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.output == "" {
+		_, err = os.Stdout.Write(src)
+
+		return err
+	}
+
+	return os.WriteFile(opts.output, src, 0o644)
+}
+
+// generate returns the formatted Go source file for the provided options.
+func generate(opts options) ([]byte, error) {
+	if opts.pkg == "" {
+		return nil, errPackageRequired
+	}
+
+	if opts.name == "" || !unicode.IsUpper([]rune(opts.name)[0]) {
+		return nil, fmt.Errorf("%w: got '%s'", errNameInvalid, opts.name)
+	}
+
+	if opts.radius == "" {
+		return nil, errRadiusRequired
+	}
+
+	radius, err := shopspringdecimal.NewFromString(opts.radius)
+	if err != nil {
+		return nil, fmt.Errorf("parsing convergence radius: %w", err)
+	}
+
+	cfg := shopspring.Config{
+		Root:              int32(opts.root),
+		Precision:         int32(opts.precision),
+		ConvergenceRadius: radius,
+		MaxTermsCache:     int32(opts.maxTermsCache),
+		GuardDigits:       int32(opts.guardDigits),
+		AutoGuardDigits:   opts.autoGuard,
+	}
+
+	calc, err := shopspring.NewCalculator(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("building calculator: %w", err)
+	}
+
+	// The JSON form of the cache is used to read the terms in their String() representation.
+	data, err := calc.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("encoding terms cache: %w", err)
+	}
+
+	var cache struct {
+		Terms []string `json:"terms"`
+	}
+
+	err = json.Unmarshal(data, &cache)
+	if err != nil {
+		return nil, fmt.Errorf("decoding terms cache: %w", err)
+	}
+
+	var buf bytes.Buffer
+
+	err = fileTemplate.Execute(&buf, templateData{
+		Command:       commandLine(opts),
+		Package:       opts.pkg,
+		Name:          opts.name,
+		TermsVar:      strings.ToLower(opts.name[:1]) + opts.name[1:] + "Terms",
+		Root:          opts.root,
+		Precision:     opts.precision,
+		Radius:        radius.String(),
+		MaxTermsCache: opts.maxTermsCache,
+		GuardDigits:   opts.guardDigits,
+		AutoGuard:     opts.autoGuard,
+		Terms:         cache.Terms,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing template: %w", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+
+	return src, nil
+}
+
+// commandLine returns the flags that reproduce the generated file, except for the output.
+func commandLine(opts options) string {
+	cmd := fmt.Sprintf("tsratecalc-gen -root %d -precision %d -radius %s", opts.root, opts.precision, opts.radius)
+
+	if opts.maxTermsCache != 0 {
+		cmd += fmt.Sprintf(" -max-terms %d", opts.maxTermsCache)
+	}
+
+	if opts.guardDigits != 0 {
+		cmd += fmt.Sprintf(" -guard-digits %d", opts.guardDigits)
+	}
+
+	if opts.autoGuard {
+		cmd += " -auto-guard-digits"
+	}
+
+	return cmd + fmt.Sprintf(" -package %s -name %s", opts.pkg, opts.name)
+}
+
+type templateData struct {
+	Command       string
+	Package       string
+	Name          string
+	TermsVar      string
+	Root          int
+	Precision     int
+	Radius        string
+	MaxTermsCache int
+	GuardDigits   int
+	AutoGuard     bool
+	Terms         []string
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by {{ .Command }}. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"slices"
+
+	shopspringdecimal "github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+// {{ .Name }}Config returns the Config used to generate the {{ .Name }} Taylor terms.
+func {{ .Name }}Config() shopspring.Config {
+	return shopspring.Config{
+		Root:              {{ .Root }},
+		Precision:         {{ .Precision }},
+		ConvergenceRadius: shopspringdecimal.RequireFromString("{{ .Radius }}"),
+{{- if .MaxTermsCache }}
+		MaxTermsCache:     {{ .MaxTermsCache }},
+{{- end }}
+{{- if .GuardDigits }}
+		GuardDigits:       {{ .GuardDigits }},
+{{- end }}
+{{- if .AutoGuard }}
+		AutoGuardDigits:   true,
+{{- end }}
+	}
+}
+
+// New{{ .Name }} returns a Calculator for {{ .Name }}Config, using the precomputed Taylor terms.
+func New{{ .Name }}() (*shopspring.Calculator, error) {
+	return shopspring.NewCalculatorFromTerms({{ .Name }}Config(), {{ .TermsVar }})
+}
+
+// {{ .Name }}Terms returns a copy of the precomputed Taylor terms for {{ .Name }}Config, in their String() representation.
+func {{ .Name }}Terms() []string {
+	return slices.Clone({{ .TermsVar }})
+}
+
+// {{ .TermsVar }} are the {{ len .Terms }} precomputed Taylor terms for {{ .Name }}Config.
+// It's shared by every call, so it must not be modified: {{ .Name }}Terms returns a copy instead.
+var {{ .TermsVar }} = []string{
+{{- range .Terms }}
+	"{{ . }}",
+{{- end }}
+}
+`))
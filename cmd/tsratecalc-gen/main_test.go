@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestGenerate_Reproducible(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		opts options
+		file string
+	}{
+		{
+			name: "business days with 30 digits",
+			opts: options{
+				root:      252,
+				precision: 30,
+				radius:    "0.9",
+				pkg:       "tables",
+				name:      "Business252",
+			},
+			file: "../../shopspring/tables/business252.go",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			want, err := os.ReadFile(tc.file)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			got, err := generate(tc.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !bytes.Equal(got, want) {
+				t.Fatalf("generated code differs from '%s', run go generate to update it", tc.file)
+			}
+		})
+	}
+}
+
+func TestGenerate_InvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	valid := options{
+		root:      252,
+		precision: 10,
+		radius:    "0.5",
+		pkg:       "tables",
+		name:      "Business252",
+	}
+
+	testCases := []struct {
+		name    string
+		modify  func(opts *options)
+		wantErr error
+	}{
+		{name: "missing package", modify: func(opts *options) { opts.pkg = "" }, wantErr: errPackageRequired},
+		{name: "unexported name", modify: func(opts *options) { opts.name = "business252" }, wantErr: errNameInvalid},
+		{name: "missing name", modify: func(opts *options) { opts.name = "" }, wantErr: errNameInvalid},
+		{name: "missing radius", modify: func(opts *options) { opts.radius = "" }, wantErr: errRadiusRequired},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := valid
+			tc.modify(&opts)
+
+			_, err := generate(opts)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("unexpected error: got %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -1,43 +1,172 @@
 package tsratecalc
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
-var ErrRateOutsideConvergenceBoundaries = fmt.Errorf("rate is outside convergence boundaries")
+var (
+	ErrRateOutsideConvergenceBoundaries = fmt.Errorf("rate is outside convergence boundaries")
+	ErrPrecisionAboveConfig             = fmt.Errorf("precision should be lower than or equal to the configured precision")
+)
 
 // ComputeRate receives a rate value and returns "(1+rate)^(1/root) - 1" using a Taylor Series expansion around rate=0.
 // The root is defined in the calculator Config.
 //
 // The rate value should fall within the Config.ConvergenceRadius interval, around rate=0,
-// otherwise ErrRateOutsideConvergenceBoundaries will be returned.
+// otherwise BoundaryError (wrapping ErrRateOutsideConvergenceBoundaries) will be returned.
 //
 // It will return ConvergenceError if the desired precision is not achieved after the maximum number of iterations.
+//
+// If Config.Verify is enabled, it will return VerificationError if the result doesn't pass the self-verification.
 func (c *Calculator[Decimal]) ComputeRate(rate Decimal) (Decimal, error) {
+	return c.ComputeRateWithPrecision(rate, c.precision)
+}
+
+// ComputeRateWithPrecision is the same as ComputeRate, but the result will have the provided number of decimal places,
+// instead of the Config.Precision. On SignificantDigits mode, it's the number of significant digits instead.
+//
+// The precision should be lower than or equal to the Config.Precision, otherwise ErrPrecisionAboveConfig is returned.
+// It reuses the calculator's Taylor terms cache, stopping as soon as the error is lower than 10^(-precision)/2.
+func (c *Calculator[Decimal]) ComputeRateWithPrecision(rate Decimal, precision uint64) (Decimal, error) {
+	if c.observer == nil && c.logger == nil {
+		res, _, err := c.computeRateWithPrecision(rate, precision)
+
+		return res, err
+	}
+
+	start := time.Now()
+
+	res, terms, err := c.computeRateWithPrecision(rate, precision)
+
+	duration := time.Since(start)
+
+	if c.observer != nil {
+		c.observer.OnCompute(duration, terms, err)
+	}
+
+	if c.logger != nil {
+		c.logCompute(rate, res, precision, terms, duration, err)
+	}
+
+	return res, err
+}
+
+// computeRateWithPrecision implements ComputeRateWithPrecision, also returning the number of Taylor terms used.
+func (c *Calculator[Decimal]) computeRateWithPrecision(rate Decimal, precision uint64) (Decimal, int, error) {
+	if precision > c.precision {
+		return c.zero, 0, fmt.Errorf("%w: configured precision is %d and requested precision is %d", ErrPrecisionAboveConfig, c.precision, precision)
+	}
+
+	places, err := c.resultPlaces(rate, precision)
+	if err != nil {
+		return c.zero, 0, err
+	}
+
+	res, terms, err := c.computeRate(rate, places)
+	if err != nil {
+		return c.zero, terms, err
+	}
+
+	if c.verify {
+		err = c.verifyResult(rate, res, places)
+		if err != nil {
+			return c.zero, terms, err
+		}
+	}
+
+	return res, terms, nil
+}
+
+// resultPlaces returns the number of decimal places of the result, given the requested precision.
+// On SignificantDigits mode, it depends on the magnitude of the first Taylor term (rate/root).
+func (c *Calculator[Decimal]) resultPlaces(rate Decimal, precision uint64) (uint64, error) {
+	if c.precisionMode != SignificantDigits {
+		return precision, nil
+	}
+
+	firstTerm, err := rate.Mul(c.firstTermCoefficient)
+	if err != nil {
+		return 0, newOperatorError("Mul", "computing first taylor term", 1, err)
+	}
+
+	firstTerm, err = firstTerm.Abs()
+	if err != nil {
+		return 0, newOperatorError("Abs", "computing first taylor term absolute value", 1, err)
+	}
+
+	places, err := significantPlaces(firstTerm, precision, c.powersOfTen)
+	if err != nil {
+		return 0, fmt.Errorf("computing result decimal places: %w", err)
+	}
+
+	return places, nil
+}
+
+// computeRate computes the rate with the provided number of decimal places, returning the number of Taylor terms used.
+func (c *Calculator[Decimal]) computeRate(rate Decimal, precision uint64) (Decimal, int, error) {
 	err := c.validateConvergence(rate)
 	if err != nil {
-		return c.zero, fmt.Errorf("validating boundaries: %w", err)
+		return c.zero, 0, fmt.Errorf("validating boundaries: %w", err)
 	}
 
+	// The thresholds are computed for the configured precision only.
+	if c.thresholds != nil && precision == c.precision {
+		absRate, err := rate.Abs()
+		if err != nil {
+			return c.zero, 0, newOperatorError("Abs", "computing rate absolute value", 0, err)
+		}
+
+		n, err := c.thresholds.lookup(absRate)
+		if err != nil {
+			return c.zero, 0, err
+		}
+
+		if n > 0 {
+			res, err := c.computeRateFixed(rate, n, precision)
+
+			return res, n, err
+		}
+	}
+
+	maxError := c.maxErrors[precision]
+
 	var (
 		res = c.zero
 		// lastError stores the last computed term. It's used to detail the error message, if it happens.
 		lastError = c.zero
 
 		variableComponent = c.one
+
+		taylorTerms = c.taylorTerms.load()
 	)
 
 	// Will loop until what happens first:
 	// - the desired precision is achieved.
 	// - the maximum number of iterations is achieved.
-	for n := uint64(1); n < uint64(len(c.taylorTerms)); n++ {
+	for n := uint64(1); ; n++ {
+		if n >= uint64(len(taylorTerms)) {
+			// Lazy caches will grow on demand, other caches will return the same terms.
+			taylorTerms, err = c.taylorTerms.grow(n + 1)
+			if err != nil {
+				return c.zero, int(n), err
+			}
+
+			if n >= uint64(len(taylorTerms)) {
+				break
+			}
+		}
+
 		// variableComponent is rate^n
 		variableComponent, err = variableComponent.Mul(rate)
 		if err != nil {
-			return c.zero, fmt.Errorf("computing rate^%d: %w", n, err)
+			return c.zero, int(n), newOperatorError("Mul", "computing rate^n", n, err)
 		}
 
-		currentTermValue, err := c.taylorTerms[n-1].Mul(variableComponent)
+		currentTermValue, err := taylorTerms[n-1].Mul(variableComponent)
 		if err != nil {
-			return c.zero, fmt.Errorf("computing current taylor term: %w", err)
+			return c.zero, int(n), newOperatorError("Mul", "computing current taylor term", n, err)
 		}
 
 		// Error checking
@@ -47,12 +176,12 @@ func (c *Calculator[Decimal]) ComputeRate(rate Decimal) (Decimal, error) {
 
 			currentErrorAbs, err := currentError.Abs()
 			if err != nil {
-				return c.zero, fmt.Errorf("computing taylor aproximation error absolute value: %w", err)
+				return c.zero, int(n), newOperatorError("Abs", "computing taylor aproximation error absolute value", n, err)
 			}
 
-			b, err := currentErrorAbs.LessThanOrEqual(c.maxError)
+			b, err := currentErrorAbs.LessThanOrEqual(maxError)
 			if err != nil {
-				return c.zero, fmt.Errorf("checking if current error is less than max error: %w", err)
+				return c.zero, int(n), newOperatorError("LessThanOrEqual", "checking if current error is less than max error", n, err)
 			}
 
 			lastError = currentErrorAbs
@@ -63,46 +192,99 @@ func (c *Calculator[Decimal]) ComputeRate(rate Decimal) (Decimal, error) {
 
 		res, err = res.Add(currentTermValue)
 		if err != nil {
-			return c.zero, fmt.Errorf("adding current term to result: %w", err)
+			return c.zero, int(n), newOperatorError("Add", "adding current term to result", n, err)
 		}
 
 		if shouldStop {
-			res, err = res.Truncate(c.precision)
+			res, err = res.Truncate(precision)
 			if err != nil {
-				return c.zero, fmt.Errorf("rounding final result: %w", err)
+				return c.zero, int(n), newOperatorError("Truncate", "rounding final result", 0, err)
 			}
 
-			return res, nil
+			return res, int(n), nil
 		}
 	}
 
 	// The loop has ended due to the maximum number of iterations being achieved.
-	return c.zero, &ConvergenceError[Decimal]{
-		Precision:     c.precision,
+	return c.zero, len(taylorTerms), &ConvergenceError[Decimal]{
+		Precision:     precision,
 		Rate:          rate,
-		Iterations:    len(c.taylorTerms),
+		Iterations:    len(taylorTerms),
 		LastError:     lastError,
 		PartialResult: res,
 	}
 }
 
+// computeRateFixed evaluates the first n Taylor terms with the configured Evaluation, without checking the error of each term.
+// The number of terms should guarantee the desired precision (see termThresholds).
+func (c *Calculator[Decimal]) computeRateFixed(rate Decimal, n int, precision uint64) (Decimal, error) {
+	coefficients := c.taylorTerms.load()[:n]
+
+	var (
+		res Decimal
+		err error
+	)
+
+	switch c.evaluation {
+	case EvaluationPowers:
+		res, err = evaluatePowers(rate, coefficients)
+	case EvaluationEstrin:
+		res, err = evaluateEstrin(rate, coefficients)
+	default:
+		res, err = evaluateHorner(rate, coefficients)
+	}
+
+	if err != nil {
+		return c.zero, err
+	}
+
+	res, err = res.Truncate(precision)
+	if err != nil {
+		return c.zero, newOperatorError("Truncate", "rounding final result", 0, err)
+	}
+
+	return res, nil
+}
+
 func (c *Calculator[Decimal]) validateConvergence(rate Decimal) error {
-	outOfRange, err := rate.LessThanOrEqual(c.convergenceLowerBoundary)
+	if c.expansion == nil {
+		return checkBoundaries(rate, c.convergenceLowerBoundary, c.convergenceUpperBoundary)
+	}
+
+	current := c.expansion.load()
+
+	err := checkBoundaries(rate, current.lower, current.upper)
+	if !errors.Is(err, ErrRateOutsideConvergenceBoundaries) {
+		return err
+	}
+
+	_, expandErr := c.expansion.expand(rate, c.zero)
+	if expandErr != nil {
+		// The BoundaryError is kept, so the failed expansion is still a rate outside the boundaries.
+		return fmt.Errorf("%w: %w", err, expandErr)
+	}
+
+	return nil
+}
+
+// checkBoundaries returns ErrRateOutsideConvergenceBoundaries if the rate is outside the provided boundaries.
+func checkBoundaries[Decimal Operator[Decimal]](rate, lower, upper Decimal) error {
+	outOfRange, err := rate.LessThanOrEqual(lower)
 	if err != nil {
-		return fmt.Errorf("comparing rate with lower convergence boundary: %w", err)
+		return newOperatorError("LessThanOrEqual", "comparing rate with lower convergence boundary", 0, err)
 	}
 
 	if outOfRange {
-		return fmt.Errorf("%w: lower boundary is '%s' and rate to compute is '%s'", ErrRateOutsideConvergenceBoundaries, c.convergenceLowerBoundary.String(), rate.String())
+		return &BoundaryError[Decimal]{Lower: lower, Upper: upper, Rate: rate}
 	}
 
-	insideRange, err := rate.LessThanOrEqual(c.convergenceUpperBoundary)
+	insideRange, err := rate.LessThanOrEqual(upper)
 	if err != nil {
-		return fmt.Errorf("comparing rate with upper convergence boundary: %w", err)
+		return newOperatorError("LessThanOrEqual", "comparing rate with upper convergence boundary", 0, err)
 	}
 
 	if !insideRange {
-		return fmt.Errorf("%w: upper boundary is '%s' and rate to compute is '%s'", ErrRateOutsideConvergenceBoundaries, c.convergenceUpperBoundary.String(), rate.String())
+		return &BoundaryError[Decimal]{Lower: lower, Upper: upper, Rate: rate}
 	}
 
 	return nil
@@ -3,6 +3,7 @@ package tsratecalc
 import (
 	"errors"
 	"fmt"
+	"log/slog"
 )
 
 const (
@@ -17,6 +18,7 @@ var (
 	ErrConfigRootMinValue              = fmt.Errorf("root should be greater than %d", minRoot)
 	ErrConfigPrecisionMinValue         = fmt.Errorf("precision should be greater than %d", minPrecision)
 	ErrConfigNewFromIntIsNil           = errors.New("'decimal from integer' factory should not be nil")
+	ErrConfigNewFromStringIsNil        = errors.New("'decimal from string' factory should not be nil")
 	ErrConfigConvergenceRadiusPositive = errors.New("convergence radius must be positive")
 )
 
@@ -27,31 +29,117 @@ type Config[Decimal Operator[Decimal]] struct {
 
 	// Precision is the number of decimal places to consider in the calculations.
 	// The calculation will only stop when the error is lower than 10^(-precision)/2.
+	//
+	// With PrecisionMode set to SignificantDigits, it's the number of significant digits instead.
 	Precision uint64
 
+	// PrecisionMode defines how Precision is interpreted. If not provided, DecimalPlaces will be used.
+	PrecisionMode PrecisionMode
+
 	// NewFromInt is a factory function that creates a Decimal from an integer.
 	NewFromInt func(n uint64) (Decimal, error)
 
+	// NewFromString is a factory function that creates a Decimal from its String() representation.
+	// It's optional, but required to load a persisted Taylor terms cache (e.g. Calculator.UnmarshalBinary).
+	NewFromString func(s string) (Decimal, error)
+
 	// ConvergenceRadius sets the desired convergence radius for the rate value,
 	// and will dynamically define how many Taylor Series terms will be used and pre-computed.
 	//
 	// The calculator will expand Taylor Series around x=0, until the convergence radius
 	// boundaries (i.e. 0 + radius and 0 - radius) have error lower than the provided precision.
 	//
-	// It's recommended to be lower than 1. If it's greater than 1, the number of iterations (and Taylor terms cache)
-	// required to converge on boundaries will grow exponentially.
+	// It should be lower than 1, since the number of terms required to converge on the boundaries has no bound otherwise:
+	// a radius greater than or equal to 1 is rejected with TermsLimitError.
+	//
+	// It's ignored if AutoRadius is provided.
 	ConvergenceRadius Decimal
 
+	// AutoRadius derives the ConvergenceRadius from the expected rates domain, with a safety margin.
+	// If not provided, ConvergenceRadius will be used.
+	AutoRadius *AutoRadius[Decimal]
+
 	// MaxTermsCache is the maximum number of Taylor terms that will be cached.
 	// If not provided, DefaultMaxTermsCache will be used.
+	//
+	// Configs whose predicted number of terms (see EstimateTerms) clearly doesn't fit are rejected with TermsLimitError.
 	MaxTermsCache uint64
+
+	// LazyTermsCache enables the lazy growth of the Taylor terms cache.
+	//
+	// Instead of computing every term required to converge on the ConvergenceRadius boundaries,
+	// the cache will only grow as far as required by the largest absolute rate value computed so far.
+	// It makes NewCalculator much faster, but the first ComputeRate calls will be slower.
+	//
+	// The convergence on the ConvergenceRadius boundaries is only predicted by NewCalculator (see EstimateTerms),
+	// so a ConvergenceError could be returned by ComputeRate if MaxTermsCache terms are not enough.
+	LazyTermsCache bool
+
+	// Verify enables the self-verification of every computed rate.
+	// After computing "y = (1+x)^(1/n)-1", the calculator will check if "(1+y)^n" is close enough to "1+x",
+	// returning VerificationError otherwise.
+	//
+	// The power is computed with 10 decimal places more than Precision, so it's slower than the computation itself.
+	Verify bool
+
+	// GuardDigits is the number of extra decimal places used by the intermediate calculations, on top of Precision.
+	// The Taylor terms are computed with Precision+GuardDigits places, and the series is only truncated when
+	// the error is lower than 10^-(Precision+GuardDigits-1)/2, so the rounding errors of hundreds of terms
+	// don't reach the result precision.
+	//
+	// If not provided, a single guard digit is used, unless AutoGuardDigits is set.
+	GuardDigits uint64
+
+	// AutoGuardDigits derives GuardDigits from MaxTermsCache when it's not provided: the number of its decimal digits,
+	// plus one (6 for DefaultMaxTermsCache). It gives exact truncated results near the ConvergenceRadius boundaries,
+	// where a single guard digit could be off by a few units in the last place, at the cost of more Taylor terms.
+	AutoGuardDigits bool
+
+	// Evaluation is the scheme used to evaluate the Taylor polynomial, once the number of terms is known
+	// (see LazyTermsCache). If not provided, EvaluationHorner will be used.
+	//
+	// Every scheme gives the same result for decimals with exact multiplication and addition.
+	Evaluation Evaluation
+
+	// Observer receives the Calculator events, e.g. to export metrics. If not provided, no event is reported.
+	//
+	// It doesn't change the computed values, so calculators with different observers are equivalent for Registry.Get.
+	Observer Observer
+
+	// Logger receives the Calculator lifecycle and failure logs, with the root, precision and convergence radius as attributes:
+	// builds (Info), rejected configs, build failures and diverging boundaries (Error), canceled builds and rates that could not converge (Warn).
+	// If not provided, nothing is logged.
+	Logger *slog.Logger
+
+	// LogComputations enables a Debug log for every computation, with the rate, result and number of terms used.
+	// It requires Logger, and the debug level being enabled on it.
+	LogComputations bool
 }
 
 func validateConfig[Decimal Operator[Decimal]](cfg Config[Decimal]) (Config[Decimal], error) {
+	cfg, err := validateRootedConfig(cfg)
+	if err != nil {
+		return Config[Decimal]{}, err
+	}
+
+	if err := validateTermsLimit(cfg); err != nil {
+		return Config[Decimal]{}, err
+	}
+
+	return cfg, nil
+}
+
+// validateRootedConfig validates every Config field, without predicting the number of Taylor terms.
+func validateRootedConfig[Decimal Operator[Decimal]](cfg Config[Decimal]) (Config[Decimal], error) {
 	if cfg.Root < minRoot {
 		return Config[Decimal]{}, ErrConfigRootMinValue
 	}
 
+	return validateRootlessConfig(cfg)
+}
+
+// validateRootlessConfig validates every Config field, except for the Root.
+func validateRootlessConfig[Decimal Operator[Decimal]](cfg Config[Decimal]) (Config[Decimal], error) {
 	if cfg.Precision < minPrecision {
 		return Config[Decimal]{}, ErrConfigPrecisionMinValue
 	}
@@ -65,6 +153,34 @@ func validateConfig[Decimal Operator[Decimal]](cfg Config[Decimal]) (Config[Deci
 		return Config[Decimal]{}, fmt.Errorf("creating '0' decimal: %w", err)
 	}
 
+	if cfg.MaxTermsCache == 0 {
+		cfg.MaxTermsCache = DefaultMaxTermsCache
+	}
+
+	if cfg.GuardDigits == 0 {
+		cfg.GuardDigits = 1
+
+		if cfg.AutoGuardDigits {
+			cfg.GuardDigits = autoGuardDigits(cfg.MaxTermsCache)
+		}
+	}
+
+	if cfg.AutoRadius != nil {
+		// Copying the AutoRadius, so the defaults don't change the caller's value.
+		autoRadius := *cfg.AutoRadius
+
+		if autoRadius.MarginPercent == 0 {
+			autoRadius.MarginPercent = DefaultAutoRadiusMarginPercent
+		}
+
+		cfg.AutoRadius = &autoRadius
+
+		cfg.ConvergenceRadius, err = autoRadius.radius(cfg)
+		if err != nil {
+			return Config[Decimal]{}, fmt.Errorf("computing auto radius: %w", err)
+		}
+	}
+
 	neg, err := cfg.ConvergenceRadius.LessThanOrEqual(zero)
 	if err != nil {
 		return Config[Decimal]{}, fmt.Errorf("checking if convergence radius is less than or equal to zero: %w", err)
@@ -74,9 +190,20 @@ func validateConfig[Decimal Operator[Decimal]](cfg Config[Decimal]) (Config[Deci
 		return Config[Decimal]{}, ErrConfigConvergenceRadiusPositive
 	}
 
-	if cfg.MaxTermsCache == 0 {
-		cfg.MaxTermsCache = DefaultMaxTermsCache
+	if !cfg.PrecisionMode.valid() {
+		return Config[Decimal]{}, fmt.Errorf("%w: %s", ErrConfigPrecisionModeInvalid, cfg.PrecisionMode)
+	}
+
+	if !cfg.Evaluation.valid() {
+		return Config[Decimal]{}, fmt.Errorf("%w: %s", ErrConfigEvaluationInvalid, cfg.Evaluation)
 	}
 
 	return cfg, nil
 }
+
+// autoGuardDigits returns the number of guard digits for a calculator with up to maxTermsCache terms.
+// The rounding error of each term is lower than one unit at the working precision, so the accumulated error of
+// maxTermsCache terms needs as many extra digits as maxTermsCache has, plus one for the result rounding.
+func autoGuardDigits(maxTermsCache uint64) uint64 {
+	return decimalDigits(maxTermsCache) + 1
+}
@@ -0,0 +1,175 @@
+package tsratecalc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var ErrConverterLengthInvalid = errors.New("converter period lengths should be positive")
+
+// Converter converts rates from a source period to a target period of any length, computing "(1+rate)^(p/q)-1"
+// where "p/q" is the reduced ratio between the target and the source period lengths.
+//
+// It's safe for concurrent use, like Calculator.
+type Converter[Decimal Operator[Decimal]] struct {
+	calculatorBase[Decimal]
+
+	// power is the "p" in the reduced exponent "p/q".
+	power uint64
+	// root is the "q" in the reduced exponent "p/q".
+	root uint64
+	// calc computes the root. It's nil if the root is 1.
+	calc *Calculator[Decimal]
+}
+
+// NewConverter returns a Converter from periods of fromLength to periods of toLength, e.g. 21 to 1 for monthly to
+// business-daily rates, or 1 to 3 for monthly to quarterly rates. The lengths could be in any unit (e.g. days or months),
+// as long as both use the same one. The Config.Root is ignored, since it's defined by the lengths ratio.
+//
+// The exponent "toLength/fromLength" is reduced to "p/q", then the rate is converted by:
+//   - "(1+rate)^p - 1" with PowInt, if q is 1 (e.g. daily to monthly).
+//   - ComputeRate with root q, if p is 1 (e.g. monthly to daily).
+//   - ComputeRate with root q and extra decimal places, then "(1+result)^p - 1" with PowInt, otherwise.
+//
+// The extra decimal places absorb the error amplification of the power, so the result is as precise as ComputeRate,
+// for rates within a convergence radius lower than 1.
+func NewConverter[Decimal Operator[Decimal]](cfg Config[Decimal], fromLength, toLength uint64) (*Converter[Decimal], error) {
+	if fromLength == 0 || toLength == 0 {
+		return nil, ErrConverterLengthInvalid
+	}
+
+	cfg, err := validateRootlessConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating config: %w", err)
+	}
+
+	base, err := newCalculatorBase(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	divisor := gcd(fromLength, toLength)
+
+	c := &Converter[Decimal]{
+		calculatorBase: base,
+		power:          toLength / divisor,
+		root:           fromLength / divisor,
+	}
+
+	if c.root == 1 {
+		return c, nil
+	}
+
+	rootCfg := cfg
+	rootCfg.Root = c.root
+
+	if c.power > 1 {
+		rootCfg.Precision += converterExtraPlaces(c.power, c.root)
+	}
+
+	c.calc, err = NewCalculator(rootCfg)
+	if err != nil {
+		return nil, fmt.Errorf("building calculator for root %d: %w", c.root, err)
+	}
+
+	return c, nil
+}
+
+// NewPeriodConverter returns a Converter from rates of the Period from to rates of the Period to.
+// See NewConverter for details.
+//
+// Periods with different day counts return PeriodConversionError wrapping ErrPeriodDayCountMismatch.
+func NewPeriodConverter[Decimal Operator[Decimal]](cfg Config[Decimal], from, to Period) (*Converter[Decimal], error) {
+	if from.PerYear == 0 || to.PerYear == 0 {
+		return nil, &PeriodConversionError{From: from, To: to, Err: ErrPeriodInvalid}
+	}
+
+	if !from.DayCount.compatible(to.DayCount) {
+		return nil, &PeriodConversionError{
+			From: from,
+			To:   to,
+			Err:  fmt.Errorf("%w: %s and %s", ErrPeriodDayCountMismatch, from.DayCount, to.DayCount),
+		}
+	}
+
+	// The period length is the inverse of the number of periods per year.
+	return NewConverter(cfg, to.PerYear, from.PerYear)
+}
+
+// converterExtraPlaces returns the number of extra decimal places of the root, so "(1+root)^power" keeps the precision.
+// The error of the root is multiplied by up to "power * (1+radius)^(power/root)", where the radius is lower than 1.
+func converterExtraPlaces(power, root uint64) uint64 {
+	return decimalDigits(power) + uint64(math.Ceil(float64(power)/float64(root)*math.Log10(2))) + 1
+}
+
+// gcd returns the greatest common divisor of a and b.
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+// Exponent returns the reduced exponent "p/q" used by the conversions.
+func (c *Converter[Decimal]) Exponent() (p, q uint64) {
+	return c.power, c.root
+}
+
+// Convert receives a rate of the source period and returns the equivalent rate of the target period,
+// "(1+rate)^(p/q) - 1", with Config.Precision decimal places (or significant digits, on SignificantDigits mode).
+//
+// The rate should fall within the Config.ConvergenceRadius interval if q is greater than 1.
+// See Calculator.ComputeRate for details about the returned errors.
+func (c *Converter[Decimal]) Convert(rate Decimal) (Decimal, error) {
+	if c.root == 1 && c.power == 1 {
+		return c.round(rate)
+	}
+
+	if c.calc == nil {
+		res, err := c.compound(rate)
+		if err != nil {
+			return c.zero, err
+		}
+
+		return c.round(res)
+	}
+
+	res, err := c.calc.ComputeRate(rate)
+	if err != nil {
+		return c.zero, err
+	}
+
+	// The root is already computed with the configured precision.
+	if c.power == 1 {
+		return res, nil
+	}
+
+	res, err = c.compound(res)
+	if err != nil {
+		return c.zero, err
+	}
+
+	return c.round(res)
+}
+
+// compound returns "(1+rate)^power - 1".
+func (c *Converter[Decimal]) compound(rate Decimal) (Decimal, error) {
+	res, err := c.one.Add(rate)
+	if err != nil {
+		return c.zero, newOperatorError("Add", "computing 1+rate", 0, err)
+	}
+
+	res, err = res.PowInt(c.power)
+	if err != nil {
+		return c.zero, newOperatorError("PowInt", "computing (1+rate)^p", 0, err)
+	}
+
+	res, err = res.Sub(c.one)
+	if err != nil {
+		return c.zero, newOperatorError("Sub", "computing (1+rate)^p-1", 0, err)
+	}
+
+	return res, nil
+}
@@ -4,6 +4,10 @@ package tsratecalc
 // and compute (1+x)^(1/n)-1 using Taylor series expansion around x=0.
 //
 // Most operations could return an error if the operation is not possible for some reason (e.g. overflows on fixed precision decimals).
+//
+// Operations must not modify the receiver or the arguments, since a Calculator shares its cached decimals between
+// concurrent ComputeRate calls. If the values share mutable state (e.g. a context or a scratch buffer),
+// the type should implement Cloner, and each goroutine should use its own Calculator.Clone.
 type Operator[Decimal any] interface {
 	// Mul multiply two decimals.
 	Mul(n Decimal) (Decimal, error)
@@ -24,3 +28,32 @@ type Operator[Decimal any] interface {
 	// String returns the string representation of the decimal.
 	String() string
 }
+
+// Cloner is implemented by Decimal types whose values share mutable state, so they can't be used concurrently.
+// Clone returns a copy that doesn't share any mutable state with the receiver.
+type Cloner[Decimal any] interface {
+	Clone() Decimal
+}
+
+// cloneDecimal returns a Clone of the decimal if it implements Cloner, otherwise it returns the decimal itself.
+func cloneDecimal[Decimal any](d Decimal) Decimal {
+	if c, ok := any(d).(Cloner[Decimal]); ok {
+		return c.Clone()
+	}
+
+	return d
+}
+
+// cloneDecimals returns a new slice with a Clone of each decimal.
+func cloneDecimals[Decimal any](ds []Decimal) []Decimal {
+	if ds == nil {
+		return nil
+	}
+
+	cloned := make([]Decimal, len(ds))
+	for i, d := range ds {
+		cloned[i] = cloneDecimal(d)
+	}
+
+	return cloned
+}
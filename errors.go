@@ -1,8 +1,14 @@
 package tsratecalc
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrRateNotConverged = errors.New("rate could not converge")
 
 // ConvergenceError is an error type for when the rate value could not converge to the desired precision.
+// It wraps ErrRateNotConverged.
 type ConvergenceError[Decimal Operator[Decimal]] struct {
 	// Precision is the desired number of decimal places to consider in the calculations.
 	Precision uint64
@@ -26,3 +32,176 @@ func (e *ConvergenceError[Decimal]) Error() string {
 		e.LastError.String(),
 	)
 }
+
+func (e *ConvergenceError[Decimal]) Unwrap() error {
+	return ErrRateNotConverged
+}
+
+// ReferenceMismatchError is an error type for when a computed rate doesn't match the exact reference.
+type ReferenceMismatchError[Decimal Operator[Decimal]] struct {
+	// Root is the root used in the calculations.
+	Root uint64
+	// Precision is the desired number of decimal places to consider in the calculations.
+	Precision uint64
+	// Rate is the rate value that was computed.
+	Rate Decimal
+	// Result is the computed result.
+	Result Decimal
+	// Reference is the exact result, truncated to the desired precision.
+	Reference string
+}
+
+func (e *ReferenceMismatchError[Decimal]) Error() string {
+	return fmt.Sprintf(
+		"rate '%s' computed with root %d resulted in '%s', but the reference with %d digits of precision is '%s'",
+		e.Rate.String(),
+		e.Root,
+		e.Result.String(),
+		e.Precision,
+		e.Reference,
+	)
+}
+
+// VerificationError is an error type for when a computed rate doesn't pass the self-verification enabled by Config.Verify.
+type VerificationError[Decimal Operator[Decimal]] struct {
+	// Root is the root used in the calculations.
+	Root uint64
+	// Precision is the desired number of decimal places to consider in the calculations.
+	Precision uint64
+	// Rate is the rate value that was computed.
+	Rate Decimal
+	// Result is the computed result.
+	Result Decimal
+	// Expected is "1+rate".
+	Expected Decimal
+	// Power is "(1+result)^root", which should be close to Expected.
+	Power Decimal
+	// Tolerance is the maximum accepted difference between Power and Expected.
+	Tolerance Decimal
+}
+
+func (e *VerificationError[Decimal]) Error() string {
+	return fmt.Sprintf(
+		"rate '%s' resulted in '%s', but (1+result)^%d is '%s', which differs from '%s' by more than '%s'",
+		e.Rate.String(),
+		e.Result.String(),
+		e.Root,
+		e.Power.String(),
+		e.Expected.String(),
+		e.Tolerance.String(),
+	)
+}
+
+// BuildCanceledError is an error type for when the context is done before the Taylor terms cache is fully built.
+// It wraps the context error, so errors.Is(err, context.DeadlineExceeded) could be used.
+type BuildCanceledError[Decimal Operator[Decimal]] struct {
+	// Err is the context error (or its cause).
+	Err error
+	// Terms is the number of Taylor terms computed before the cancellation.
+	Terms uint64
+	// LowerBoundaryError is the error on the lower convergence boundary with the computed terms.
+	LowerBoundaryError Decimal
+	// UpperBoundaryError is the error on the upper convergence boundary with the computed terms.
+	UpperBoundaryError Decimal
+}
+
+func (e *BuildCanceledError[Decimal]) Error() string {
+	return fmt.Sprintf(
+		"taylor terms cache build canceled after %d terms, lower boundary error was '%s' and upper boundary error was '%s': %v",
+		e.Terms,
+		e.LowerBoundaryError.String(),
+		e.UpperBoundaryError.String(),
+		e.Err,
+	)
+}
+
+func (e *BuildCanceledError[Decimal]) Unwrap() error {
+	return e.Err
+}
+
+// OperatorError is an error type for when an Operator method fails while computing a rate or a Taylor term.
+type OperatorError struct {
+	// Op is the Operator method that failed (e.g. "Mul").
+	Op string
+	// Step describes the computation (e.g. "computing rate^n").
+	Step string
+	// Term is the index of the Taylor term being computed, starting at 1. It's zero if the step isn't related to a term.
+	Term uint64
+	// Err is the error returned by the Operator method.
+	Err error
+}
+
+// newOperatorError returns an OperatorError for the provided Operator method failure.
+func newOperatorError(op, step string, term uint64, err error) *OperatorError {
+	return &OperatorError{
+		Op:   op,
+		Step: step,
+		Term: term,
+		Err:  err,
+	}
+}
+
+func (e *OperatorError) Error() string {
+	if e.Term == 0 {
+		return fmt.Sprintf("%s: %s failed: %v", e.Step, e.Op, e.Err)
+	}
+
+	return fmt.Sprintf("%s (taylor term %d): %s failed: %v", e.Step, e.Term, e.Op, e.Err)
+}
+
+func (e *OperatorError) Unwrap() error {
+	return e.Err
+}
+
+// DivergenceError is an error type for when the Taylor terms error grows on a convergence boundary while building the cache.
+// It wraps ErrConvergenceBoundaryDiverging.
+type DivergenceError[Decimal Operator[Decimal]] struct {
+	// Boundary is the convergence boundary (i.e. "-radius" or "radius") where the series is diverging.
+	Boundary Decimal
+	// Term is the index of the Taylor term whose error is greater than the previous one, starting at 1.
+	Term uint64
+	// TermError is the error (absolute value of the term) on the boundary.
+	TermError Decimal
+	// LastTermError is the error of the previous term on the boundary.
+	LastTermError Decimal
+}
+
+func (e *DivergenceError[Decimal]) Error() string {
+	return fmt.Sprintf(
+		"%s: boundary '%s' error grew from '%s' to '%s' on taylor term %d",
+		ErrConvergenceBoundaryDiverging.Error(),
+		e.Boundary.String(),
+		e.LastTermError.String(),
+		e.TermError.String(),
+		e.Term,
+	)
+}
+
+func (e *DivergenceError[Decimal]) Unwrap() error {
+	return ErrConvergenceBoundaryDiverging
+}
+
+// BoundaryError is an error type for when the rate is outside the convergence boundaries.
+// It wraps ErrRateOutsideConvergenceBoundaries.
+type BoundaryError[Decimal Operator[Decimal]] struct {
+	// Lower is the lower convergence boundary. Rates lower than or equal to it are outside the boundaries.
+	Lower Decimal
+	// Upper is the upper convergence boundary. Rates greater than it are outside the boundaries.
+	Upper Decimal
+	// Rate is the rate value outside the boundaries.
+	Rate Decimal
+}
+
+func (e *BoundaryError[Decimal]) Error() string {
+	return fmt.Sprintf(
+		"%s: boundaries are ('%s', '%s'] and rate to compute is '%s'",
+		ErrRateOutsideConvergenceBoundaries.Error(),
+		e.Lower.String(),
+		e.Upper.String(),
+		e.Rate.String(),
+	)
+}
+
+func (e *BoundaryError[Decimal]) Unwrap() error {
+	return ErrRateOutsideConvergenceBoundaries
+}
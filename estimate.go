@@ -0,0 +1,274 @@
+package tsratecalc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/mqzabin/tsratecalc/oracle"
+)
+
+const (
+	// estimatedTermOverhead is the estimated memory used by each cached term, besides its digits.
+	estimatedTermOverhead = 48
+	// estimatedOperationsPerTerm is the number of Operator calls used to compute and check each term.
+	estimatedOperationsPerTerm = 18
+	// estimateMinSlackTerms is the minimum number of terms that a prediction could exceed the limit by, and still be accepted.
+	estimateMinSlackTerms = 2
+	// estimateSlackDivisor defines the relative slack of the predictions: 1/100 of the limit.
+	estimateSlackDivisor = 100
+)
+
+var (
+	ErrConfigTermsExceedMaxTermsCache = errors.New("predicted number of taylor terms exceeds the max terms cache")
+	ErrEstimateRadiusNotParseable     = errors.New("convergence radius is not a parseable decimal")
+)
+
+// TermsLimitError is an error type for configs that would require more Taylor terms than Config.MaxTermsCache.
+type TermsLimitError struct {
+	// PredictedTerms is the predicted number of terms. It's math.MaxUint64 if the series doesn't converge.
+	PredictedTerms uint64
+	// MaxTermsCache is the configured maximum number of terms.
+	MaxTermsCache uint64
+	// ConvergenceRadius is the configured convergence radius.
+	ConvergenceRadius string
+	// MaxConvergenceRadius is the largest convergence radius, with 6 decimal places, that fits in MaxTermsCache terms.
+	MaxConvergenceRadius string
+}
+
+func (e *TermsLimitError) Error() string {
+	predicted := "infinite"
+	if e.PredictedTerms != math.MaxUint64 {
+		predicted = strconv.FormatUint(e.PredictedTerms, 10)
+	}
+
+	return fmt.Sprintf(
+		"%s: convergence radius '%s' requires %s terms and the max terms cache is %d, the largest radius that fits is '%s'",
+		ErrConfigTermsExceedMaxTermsCache.Error(),
+		e.ConvergenceRadius,
+		predicted,
+		e.MaxTermsCache,
+		e.MaxConvergenceRadius,
+	)
+}
+
+func (e *TermsLimitError) Unwrap() error {
+	return ErrConfigTermsExceedMaxTermsCache
+}
+
+// Cost is the predicted cost of building a Calculator Taylor terms cache.
+type Cost struct {
+	// Terms is the predicted number of Taylor terms. It's math.MaxUint64 if the series doesn't converge.
+	Terms uint64
+	// WorkingPrecision is the number of decimal places of each term.
+	WorkingPrecision uint64
+	// Bytes is the predicted memory of the terms cache, assuming each term stores its digits in binary (like math/big).
+	// The actual memory depends on the Decimal type.
+	Bytes uint64
+	// Operations is the predicted number of Operator calls to build the terms cache.
+	Operations uint64
+}
+
+// EstimateTerms predicts how many Taylor terms NewCalculator would compute for the Config, without computing them.
+//
+// It uses the asymptotics of the binomial coefficients "|c_n| = |Γ(n-1/root)| / (|Γ(-1/root)| * n!)" to find the first
+// term where the error on the convergence boundaries is lower than the max error. It's math.MaxUint64 if the
+// convergence radius is not lower than 1, where the series doesn't converge.
+//
+// The Config.ConvergenceRadius String() representation should be parseable by oracle.ParseRat.
+func EstimateTerms[Decimal Operator[Decimal]](cfg Config[Decimal]) (uint64, error) {
+	cost, err := EstimateCost(cfg)
+	if err != nil {
+		return 0, err
+	}
+
+	return cost.Terms, nil
+}
+
+// EstimateCost predicts the number of terms, memory and operations of the Config Taylor terms cache, without computing it.
+// See EstimateTerms for details.
+func EstimateCost[Decimal Operator[Decimal]](cfg Config[Decimal]) (Cost, error) {
+	cfg, err := validateRootedConfig(cfg)
+	if err != nil {
+		return Cost{}, fmt.Errorf("validating config: %w", err)
+	}
+
+	radius, err := parseRadius(cfg.ConvergenceRadius)
+	if err != nil {
+		return Cost{}, err
+	}
+
+	return estimateCost(cfg, radius), nil
+}
+
+// parseRadius returns the convergence radius as a float64.
+func parseRadius[Decimal Operator[Decimal]](radius Decimal) (float64, error) {
+	r, err := oracle.ParseRat(radius.String())
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrEstimateRadiusNotParseable, err)
+	}
+
+	f, _ := r.Float64()
+
+	return f, nil
+}
+
+// estimateCost predicts the cost of a validated Config.
+func estimateCost[Decimal Operator[Decimal]](cfg Config[Decimal], radius float64) Cost {
+	workingPrecision := cfg.Precision + cfg.GuardDigits
+	if cfg.PrecisionMode == SignificantDigits {
+		workingPrecision += decimalDigits(cfg.Root)
+	}
+
+	terms := estimateTerms(cfg, radius)
+
+	cost := Cost{
+		Terms:            terms,
+		WorkingPrecision: workingPrecision,
+		Bytes:            math.MaxUint64,
+		Operations:       math.MaxUint64,
+	}
+
+	if terms != math.MaxUint64 {
+		// Each decimal digit takes log2(10) bits.
+		termBytes := uint64(math.Ceil(float64(workingPrecision)*math.Log2(10)/8)) + estimatedTermOverhead
+
+		cost.Bytes = terms * termBytes
+		cost.Operations = terms * estimatedOperationsPerTerm
+	}
+
+	return cost
+}
+
+// estimateTerms returns the predicted number of terms of a validated Config for the provided radius.
+func estimateTerms[Decimal Operator[Decimal]](cfg Config[Decimal], radius float64) uint64 {
+	if radius >= 1 {
+		return math.MaxUint64
+	}
+
+	if radius <= 0 {
+		return 1
+	}
+
+	// The cache stops on the first term, after the first one, where the boundaries error is lower than the max error.
+	logMaxError := estimateLogMaxError(cfg, radius)
+
+	logRadius := math.Log(radius)
+
+	logBoundaryError := func(n uint64) float64 {
+		return logAbsBinomial(1/float64(cfg.Root), n) + float64(n)*logRadius
+	}
+
+	// The boundaries error decreases with n, so the first term below the max error could be found with a binary search.
+	lo, hi := uint64(2), uint64(2)
+
+	for logBoundaryError(hi) > logMaxError {
+		lo = hi
+		hi *= 2
+
+		if hi > math.MaxUint32 {
+			return math.MaxUint64
+		}
+	}
+
+	for lo < hi {
+		mid := (lo + hi) / 2
+
+		if logBoundaryError(mid) > logMaxError {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	return lo
+}
+
+// estimateLogMaxError returns the natural logarithm of the max error used to size the terms cache.
+func estimateLogMaxError[Decimal Operator[Decimal]](cfg Config[Decimal], radius float64) float64 {
+	places := cfg.Precision
+
+	if cfg.PrecisionMode == SignificantDigits {
+		// The number of leading zeros of the first term on the boundaries (radius/root) is added to the decimal places.
+		firstTerm := radius / float64(cfg.Root)
+
+		leadingZeros := uint64(0)
+		if firstTerm < 1 {
+			leadingZeros = uint64(math.Ceil(-math.Log10(firstTerm)))
+		}
+
+		if leadingZeros > 0 {
+			places = min(cfg.Precision+leadingZeros-1, cfg.Precision+significantDigitsMaxShift)
+		} else if places > 0 {
+			places--
+		}
+	}
+
+	// 10^-(places+guardDigits-1)/2
+	return -float64(places+cfg.GuardDigits-1)*math.Ln10 - math.Ln2
+}
+
+// logAbsBinomial returns "ln|binomial(a, n)|", where "binomial(a, n) = (-1)^n * Γ(n-a) / (Γ(-a) * n!)" for non-integer a.
+func logAbsBinomial(a float64, n uint64) float64 {
+	lgammaNMinusA, _ := math.Lgamma(float64(n) - a)
+	lgammaMinusA, _ := math.Lgamma(-a)
+	lgammaNPlusOne, _ := math.Lgamma(float64(n) + 1)
+
+	return lgammaNMinusA - lgammaMinusA - lgammaNPlusOne
+}
+
+// maxRadiusForTerms returns the largest convergence radius, truncated to 6 decimal places, whose predicted number
+// of terms is lower than or equal to maxTerms.
+func maxRadiusForTerms[Decimal Operator[Decimal]](cfg Config[Decimal], maxTerms uint64) string {
+	return strconv.FormatFloat(float64(maxRadiusMillionths(cfg, maxTerms))/radiusScale, 'f', 6, 64)
+}
+
+// radiusScale is the scale of maxRadiusMillionths.
+const radiusScale = 1_000_000
+
+// maxRadiusMillionths is the same as maxRadiusForTerms, but the radius is returned in millionths.
+func maxRadiusMillionths[Decimal Operator[Decimal]](cfg Config[Decimal], maxTerms uint64) uint64 {
+	lo, hi := uint64(0), uint64(radiusScale-1)
+
+	// Binary search for the last radius (in millionths) that fits.
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+
+		if estimateTerms(cfg, float64(mid)/radiusScale) <= maxTerms {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	return lo
+}
+
+// validateTermsLimit rejects validated Configs whose predicted number of terms clearly exceeds Config.MaxTermsCache.
+// Configs with a convergence radius that can't be parsed aren't checked.
+//
+// The prediction could be off by a few terms, so it's only rejected when it exceeds the limit by more than 1%
+// (or estimateMinSlackTerms). Otherwise, the actual terms are the authority: the cache is limited to MaxTermsCache terms,
+// and ComputeRate returns ConvergenceError for the rates that don't converge with them.
+func validateTermsLimit[Decimal Operator[Decimal]](cfg Config[Decimal]) error {
+	radius, err := parseRadius(cfg.ConvergenceRadius)
+	if err != nil {
+		return nil
+	}
+
+	// The cache construction stops one term before MaxTermsCache.
+	maxTerms := cfg.MaxTermsCache - 1
+
+	predicted := estimateTerms(cfg, radius)
+	if predicted <= maxTerms || predicted-maxTerms <= max(estimateMinSlackTerms, maxTerms/estimateSlackDivisor) {
+		return nil
+	}
+
+	return &TermsLimitError{
+		PredictedTerms:       predicted,
+		MaxTermsCache:        cfg.MaxTermsCache,
+		ConvergenceRadius:    cfg.ConvergenceRadius.String(),
+		MaxConvergenceRadius: maxRadiusForTerms(cfg, maxTerms),
+	}
+}
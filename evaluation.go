@@ -0,0 +1,154 @@
+package tsratecalc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Evaluation defines how the Taylor polynomial is evaluated, once the number of terms is known.
+type Evaluation int
+
+const (
+	// EvaluationHorner uses the Horner's scheme: "x*(c_1 + x*(c_2 + ... + x*c_n))".
+	// It uses a single multiplication and addition per term. It's the default.
+	EvaluationHorner Evaluation = iota
+	// EvaluationPowers accumulates "x^n" and multiplies it by each coefficient: "c_1*x + c_2*x^2 + ... + c_n*x^n".
+	// It uses two multiplications per term.
+	EvaluationPowers
+	// EvaluationEstrin uses the Estrin's scheme, which pairs the terms as "(c_1 + c_2*x) + (c_3 + c_4*x)*x^2 + ...",
+	// and repeats it with x^2, x^4, ..., so the pairs of each level are independent from each other.
+	// It has the same number of operations as Horner, but with shorter dependency chains.
+	EvaluationEstrin
+)
+
+var ErrConfigEvaluationInvalid = errors.New("invalid evaluation scheme")
+
+func (e Evaluation) String() string {
+	switch e {
+	case EvaluationHorner:
+		return "horner"
+	case EvaluationPowers:
+		return "powers"
+	case EvaluationEstrin:
+		return "estrin"
+	default:
+		return fmt.Sprintf("Evaluation(%d)", int(e))
+	}
+}
+
+func (e Evaluation) valid() bool {
+	return e >= EvaluationHorner && e <= EvaluationEstrin
+}
+
+// evaluateHorner returns "c_1*x + ... + c_n*x^n" using the Horner's scheme.
+func evaluateHorner[Decimal Operator[Decimal]](rate Decimal, coefficients []Decimal) (Decimal, error) {
+	var zero Decimal
+
+	// c_1*x + c_2*x^2 + ... + c_n*x^n = x*(c_1 + x*(c_2 + ... + x*c_n))
+	res := coefficients[len(coefficients)-1]
+
+	for i := len(coefficients) - 2; i >= 0; i-- {
+		var err error
+
+		res, err = res.Mul(rate)
+		if err != nil {
+			return zero, newOperatorError("Mul", "multiplying horner accumulator by rate", uint64(i+2), err)
+		}
+
+		res, err = res.Add(coefficients[i])
+		if err != nil {
+			return zero, newOperatorError("Add", "adding taylor term", uint64(i+1), err)
+		}
+	}
+
+	res, err := res.Mul(rate)
+	if err != nil {
+		return zero, newOperatorError("Mul", "multiplying horner accumulator by rate", 1, err)
+	}
+
+	return res, nil
+}
+
+// evaluatePowers returns "c_1*x + ... + c_n*x^n" accumulating the powers of x.
+func evaluatePowers[Decimal Operator[Decimal]](rate Decimal, coefficients []Decimal) (Decimal, error) {
+	var zero Decimal
+
+	res, err := coefficients[0].Mul(rate)
+	if err != nil {
+		return zero, newOperatorError("Mul", "computing taylor term", 1, err)
+	}
+
+	variableComponent := rate
+
+	for i := 1; i < len(coefficients); i++ {
+		// variableComponent is rate^(i+1)
+		variableComponent, err = variableComponent.Mul(rate)
+		if err != nil {
+			return zero, newOperatorError("Mul", "computing rate^n", uint64(i+1), err)
+		}
+
+		term, err := coefficients[i].Mul(variableComponent)
+		if err != nil {
+			return zero, newOperatorError("Mul", "computing taylor term", uint64(i+1), err)
+		}
+
+		res, err = res.Add(term)
+		if err != nil {
+			return zero, newOperatorError("Add", "adding taylor term", uint64(i+1), err)
+		}
+	}
+
+	return res, nil
+}
+
+// evaluateEstrin returns "c_1*x + ... + c_n*x^n" using the Estrin's scheme.
+func evaluateEstrin[Decimal Operator[Decimal]](rate Decimal, coefficients []Decimal) (Decimal, error) {
+	var zero Decimal
+
+	// level stores the coefficients of a polynomial in "pow", starting with "c_1 + c_2*x + ... + c_n*x^(n-1)".
+	level := coefficients
+	pow := rate
+
+	for len(level) > 1 {
+		next := make([]Decimal, 0, (len(level)+1)/2)
+
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+
+				break
+			}
+
+			// level[i] + level[i+1]*pow
+			v, err := level[i+1].Mul(pow)
+			if err != nil {
+				return zero, newOperatorError("Mul", "multiplying estrin pair by power", 0, err)
+			}
+
+			v, err = v.Add(level[i])
+			if err != nil {
+				return zero, newOperatorError("Add", "adding estrin pair", 0, err)
+			}
+
+			next = append(next, v)
+		}
+
+		level = next
+
+		if len(level) > 1 {
+			var err error
+
+			pow, err = pow.Mul(pow)
+			if err != nil {
+				return zero, newOperatorError("Mul", "squaring estrin power", 0, err)
+			}
+		}
+	}
+
+	res, err := level[0].Mul(rate)
+	if err != nil {
+		return zero, newOperatorError("Mul", "multiplying estrin result by rate", 0, err)
+	}
+
+	return res, nil
+}
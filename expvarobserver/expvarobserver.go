@@ -0,0 +1,183 @@
+// Package expvarobserver implements tsratecalc.Observer exporting the calculator metrics with the expvar package.
+//
+// The metrics are published as a single expvar.Map, available on "/debug/vars" when the expvar handler is registered:
+// counters for builds and computations, separate counters for rates outside the convergence boundaries and
+// rates that could not converge, and histograms of the computation latency and the number of Taylor terms used.
+package expvarobserver
+
+import (
+	"errors"
+	"expvar"
+	"strconv"
+	"time"
+
+	"github.com/mqzabin/tsratecalc"
+)
+
+// latencyBuckets are the upper bounds of the computation latency histogram buckets.
+// Latencies greater than the last bound are counted on the "inf" bucket.
+var latencyBuckets = []time.Duration{
+	time.Microsecond,
+	5 * time.Microsecond,
+	10 * time.Microsecond,
+	25 * time.Microsecond,
+	50 * time.Microsecond,
+	100 * time.Microsecond,
+	250 * time.Microsecond,
+	500 * time.Microsecond,
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+}
+
+// termsBuckets are the upper bounds of the Taylor terms used histogram buckets.
+// Numbers of terms greater than the last bound are counted on the "inf" bucket.
+var termsBuckets = []int{0, 8, 16, 32, 64, 128, 256, 512, 1024, 2048}
+
+// Observer is a tsratecalc.Observer that exports the calculator metrics as an expvar.Map. It's safe for concurrent use.
+//
+// The map has the following keys:
+//   - "builds", "build_terms" and "build_ns": the number of builds, the total Taylor terms computed by them, and their total duration.
+//   - "computes" and "compute_ns": the number of computations and their total duration.
+//   - "compute_errors": the number of computations that returned an error. It's the sum of "out_of_radius",
+//     "not_converged" and "other_errors".
+//   - "latency": the histogram of the computation latency, keyed by the bucket upper bound (e.g. "le_10µs").
+//   - "terms_used": the histogram of the Taylor terms used by successful computations, keyed by the bucket upper bound (e.g. "le_64").
+type Observer struct {
+	vars *expvar.Map
+
+	builds     *expvar.Int
+	buildTerms *expvar.Int
+	buildNanos *expvar.Int
+
+	computes      *expvar.Int
+	computeNanos  *expvar.Int
+	computeErrors *expvar.Int
+	outOfRadius   *expvar.Int
+	notConverged  *expvar.Int
+	otherErrors   *expvar.Int
+
+	latency []*expvar.Int
+	terms   []*expvar.Int
+}
+
+var _ tsratecalc.Observer = (*Observer)(nil)
+
+// New returns an Observer publishing its metrics with the provided expvar name.
+// Like expvar.Publish, it panics if the name is already in use.
+func New(name string) *Observer {
+	o := NewUnpublished()
+
+	expvar.Publish(name, o.vars)
+
+	return o
+}
+
+// NewUnpublished returns an Observer whose metrics aren't published, so the caller could publish Map later,
+// or embed it in another expvar.Map.
+func NewUnpublished() *Observer {
+	o := &Observer{
+		vars: new(expvar.Map).Init(),
+	}
+
+	o.builds = o.newInt("builds")
+	o.buildTerms = o.newInt("build_terms")
+	o.buildNanos = o.newInt("build_ns")
+	o.computes = o.newInt("computes")
+	o.computeNanos = o.newInt("compute_ns")
+	o.computeErrors = o.newInt("compute_errors")
+	o.outOfRadius = o.newInt("out_of_radius")
+	o.notConverged = o.newInt("not_converged")
+	o.otherErrors = o.newInt("other_errors")
+
+	latency := new(expvar.Map).Init()
+	o.vars.Set("latency", latency)
+
+	for _, bound := range latencyBuckets {
+		o.latency = append(o.latency, newMapInt(latency, "le_"+bound.String()))
+	}
+
+	o.latency = append(o.latency, newMapInt(latency, "inf"))
+
+	terms := new(expvar.Map).Init()
+	o.vars.Set("terms_used", terms)
+
+	for _, bound := range termsBuckets {
+		o.terms = append(o.terms, newMapInt(terms, "le_"+strconv.Itoa(bound)))
+	}
+
+	o.terms = append(o.terms, newMapInt(terms, "inf"))
+
+	return o
+}
+
+// newInt creates an expvar.Int on the Observer map.
+func (o *Observer) newInt(key string) *expvar.Int {
+	return newMapInt(o.vars, key)
+}
+
+// newMapInt creates an expvar.Int on the provided map.
+func newMapInt(m *expvar.Map, key string) *expvar.Int {
+	v := new(expvar.Int)
+	m.Set(key, v)
+
+	return v
+}
+
+// Map returns the expvar.Map with the Observer metrics.
+func (o *Observer) Map() *expvar.Map {
+	return o.vars
+}
+
+// OnBuild implements tsratecalc.Observer.
+func (o *Observer) OnBuild(duration time.Duration, terms int) {
+	o.builds.Add(1)
+	o.buildTerms.Add(int64(terms))
+	o.buildNanos.Add(int64(duration))
+}
+
+// OnCompute implements tsratecalc.Observer.
+func (o *Observer) OnCompute(duration time.Duration, termsUsed int, err error) {
+	o.computes.Add(1)
+	o.computeNanos.Add(int64(duration))
+	o.latency[latencyBucket(duration)].Add(1)
+
+	if err == nil {
+		o.terms[termsBucket(termsUsed)].Add(1)
+
+		return
+	}
+
+	o.computeErrors.Add(1)
+
+	switch {
+	case errors.Is(err, tsratecalc.ErrRateOutsideConvergenceBoundaries):
+		o.outOfRadius.Add(1)
+	case errors.Is(err, tsratecalc.ErrRateNotConverged):
+		o.notConverged.Add(1)
+	default:
+		o.otherErrors.Add(1)
+	}
+}
+
+// latencyBucket returns the index of the first latency bucket whose bound is greater than or equal to the duration.
+func latencyBucket(duration time.Duration) int {
+	for i, bound := range latencyBuckets {
+		if duration <= bound {
+			return i
+		}
+	}
+
+	return len(latencyBuckets)
+}
+
+// termsBucket returns the index of the first terms bucket whose bound is greater than or equal to the number of terms.
+func termsBucket(terms int) int {
+	for i, bound := range termsBuckets {
+		if terms <= bound {
+			return i
+		}
+	}
+
+	return len(termsBuckets)
+}
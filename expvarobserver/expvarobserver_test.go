@@ -0,0 +1,103 @@
+package expvarobserver_test
+
+import (
+	"encoding/json"
+	"errors"
+	"expvar"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/expvarobserver"
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+func TestObserver(t *testing.T) {
+	t.Parallel()
+
+	observer := expvarobserver.NewUnpublished()
+
+	calc, err := shopspring.NewCalculator(shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.RequireFromString("0.5"),
+		Observer:          observer,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	for _, rate := range []string{"0", "0.01", "-0.25", "0.1375"} {
+		_, err := calc.ComputeRate(decimal.RequireFromString(rate))
+		if err != nil {
+			t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+		}
+	}
+
+	_, err = calc.ComputeRate(decimal.RequireFromString("0.6"))
+	if !errors.Is(err, tsratecalc.ErrRateOutsideConvergenceBoundaries) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The last cached term is never used, so the boundary itself doesn't converge.
+	_, err = calc.ComputeRate(decimal.RequireFromString("0.5"))
+	if !errors.Is(err, tsratecalc.ErrRateNotConverged) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	vars := observer.Map()
+
+	for key, want := range map[string]int64{
+		"builds":         1,
+		"build_terms":    int64(calc.TermsCacheLen()),
+		"computes":       6,
+		"compute_errors": 2,
+		"out_of_radius":  1,
+		"not_converged":  1,
+		"other_errors":   0,
+	} {
+		if got := vars.Get(key).(*expvar.Int).Value(); got != want {
+			t.Fatalf("unexpected '%s' value: got %d, want %d", key, got, want)
+		}
+	}
+
+	var histograms struct {
+		Latency   map[string]int64 `json:"latency"`
+		TermsUsed map[string]int64 `json:"terms_used"`
+	}
+
+	err = json.Unmarshal([]byte(vars.String()), &histograms)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if sum(histograms.Latency) != 6 {
+		t.Fatalf("unexpected latency histogram: %v", histograms.Latency)
+	}
+
+	// Only successful computations are counted, and the zero rate uses a single term.
+	if sum(histograms.TermsUsed) != 4 || histograms.TermsUsed["le_8"] == 0 {
+		t.Fatalf("unexpected terms used histogram: %v", histograms.TermsUsed)
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	observer := expvarobserver.New("tsratecalc_test")
+
+	if expvar.Get("tsratecalc_test") != observer.Map() {
+		t.Fatalf("observer map not published")
+	}
+}
+
+func sum(m map[string]int64) int64 {
+	var total int64
+
+	for _, v := range m {
+		total += v
+	}
+
+	return total
+}
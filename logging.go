@@ -0,0 +1,126 @@
+package tsratecalc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// configAttrs returns the log attributes identifying the Config.
+func configAttrs[Decimal Operator[Decimal]](cfg Config[Decimal]) []slog.Attr {
+	return []slog.Attr{
+		slog.Uint64("root", cfg.Root),
+		slog.Uint64("precision", cfg.Precision),
+		slog.String("radius", cfg.ConvergenceRadius.String()),
+	}
+}
+
+// logBuild logs the result of a Calculator build.
+func logBuild[Decimal Operator[Decimal]](ctx context.Context, cfg Config[Decimal], calc *Calculator[Decimal], duration time.Duration, err error) {
+	attrs := append(configAttrs(cfg), slog.Duration("duration", duration))
+
+	if err == nil {
+		attrs = append(attrs, slog.Int("terms", calc.TermsCacheLen()))
+
+		cfg.Logger.LogAttrs(ctx, slog.LevelInfo, "calculator built", attrs...)
+
+		return
+	}
+
+	var divergenceErr *DivergenceError[Decimal]
+	if errors.As(err, &divergenceErr) {
+		attrs = append(attrs,
+			slog.Uint64("term", divergenceErr.Term),
+			slog.String("boundary", divergenceErr.Boundary.String()),
+			slog.String("boundary_error", divergenceErr.TermError.String()),
+			slog.String("last_boundary_error", divergenceErr.LastTermError.String()),
+		)
+	}
+
+	// Canceled builds are decided by the caller, so they're not logged as errors.
+	level := slog.LevelError
+
+	var canceledErr *BuildCanceledError[Decimal]
+	if errors.As(err, &canceledErr) {
+		level = slog.LevelWarn
+
+		attrs = append(attrs,
+			slog.Uint64("term", canceledErr.Terms),
+			slog.String("lower_boundary_error", canceledErr.LowerBoundaryError.String()),
+			slog.String("upper_boundary_error", canceledErr.UpperBoundaryError.String()),
+		)
+	}
+
+	attrs = append(attrs, slog.Any("error", err))
+
+	cfg.Logger.LogAttrs(ctx, level, "calculator build failed", attrs...)
+}
+
+// logRejectedConfig logs a Config rejected by the validation.
+// The convergence radius isn't logged, because it could be invalid (e.g. the Decimal zero value).
+func logRejectedConfig[Decimal Operator[Decimal]](ctx context.Context, cfg Config[Decimal], err error) {
+	attrs := []slog.Attr{
+		slog.Uint64("root", cfg.Root),
+		slog.Uint64("precision", cfg.Precision),
+	}
+
+	var limitErr *TermsLimitError
+	if errors.As(err, &limitErr) {
+		attrs = append(attrs,
+			slog.String("radius", limitErr.ConvergenceRadius),
+			slog.String("max_radius", limitErr.MaxConvergenceRadius),
+			slog.Uint64("max_terms_cache", limitErr.MaxTermsCache),
+		)
+	}
+
+	attrs = append(attrs, slog.Any("error", err))
+
+	cfg.Logger.LogAttrs(ctx, slog.LevelError, "calculator config rejected", attrs...)
+}
+
+// logCompute logs a rate computation: convergence failures are always logged, and the others only with LogComputations.
+func (c *Calculator[Decimal]) logCompute(rate, result Decimal, precision uint64, terms int, duration time.Duration, err error) {
+	ctx := context.Background()
+
+	var convergenceErr *ConvergenceError[Decimal]
+	if errors.As(err, &convergenceErr) {
+		c.logger.LogAttrs(ctx, slog.LevelWarn, "rate could not converge",
+			c.computeAttrs(rate, precision,
+				slog.Int("term", convergenceErr.Iterations),
+				slog.String("last_error", convergenceErr.LastError.String()),
+				slog.String("partial_result", convergenceErr.PartialResult.String()),
+				slog.Any("error", err),
+			)...,
+		)
+
+		return
+	}
+
+	if !c.logComputations || !c.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.Int("terms", terms),
+		slog.Duration("duration", duration),
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+	} else {
+		attrs = append(attrs, slog.String("result", result.String()))
+	}
+
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "rate computed", c.computeAttrs(rate, precision, attrs...)...)
+}
+
+// computeAttrs returns the log attributes identifying a computation, followed by the provided ones.
+func (c *Calculator[Decimal]) computeAttrs(rate Decimal, precision uint64, attrs ...slog.Attr) []slog.Attr {
+	return append([]slog.Attr{
+		slog.Uint64("root", c.root),
+		slog.Uint64("precision", precision),
+		slog.String("radius", c.ConvergenceRadius().String()),
+		slog.String("rate", rate.String()),
+	}, attrs...)
+}
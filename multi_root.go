@@ -0,0 +1,106 @@
+package tsratecalc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// MultiRootCalculator computes "(1+x)^(1/n)-1" for many roots "n", sharing the same Config.
+//
+// The Calculator for each root (and its Taylor terms cache) is lazily built on the first ComputeRate call
+// with that root, and reused afterward. It's safe for concurrent use.
+type MultiRootCalculator[Decimal Operator[Decimal]] struct {
+	// cfg is the validated Config, shared by all roots.
+	cfg Config[Decimal]
+	// base stores the values shared by all roots.
+	base calculatorBase[Decimal]
+
+	mu sync.Mutex
+	// calculators stores the calculator of each root requested so far.
+	calculators map[uint64]*multiRootEntry[Decimal]
+}
+
+// multiRootEntry stores a lazily built Calculator.
+type multiRootEntry[Decimal Operator[Decimal]] struct {
+	once sync.Once
+	calc *Calculator[Decimal]
+	err  error
+}
+
+// NewMultiRootCalculator returns a new MultiRootCalculator given a Config for a specific Decimal type.
+// The Config.Root is ignored, since the root is provided on each ComputeRate call.
+func NewMultiRootCalculator[Decimal Operator[Decimal]](cfg Config[Decimal]) (*MultiRootCalculator[Decimal], error) {
+	cfg, err := validateRootlessConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating config: %w", err)
+	}
+
+	base, err := newCalculatorBase(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultiRootCalculator[Decimal]{
+		cfg:         cfg,
+		base:        base,
+		calculators: make(map[uint64]*multiRootEntry[Decimal]),
+	}, nil
+}
+
+// ComputeRate receives a rate value and returns "(1+rate)^(1/root) - 1" using a Taylor Series expansion around rate=0.
+//
+// The first call for each root will build its Taylor terms cache, so it's slower than the subsequent ones.
+// See Calculator.ComputeRate for details about the returned errors.
+func (m *MultiRootCalculator[Decimal]) ComputeRate(root uint64, rate Decimal) (Decimal, error) {
+	calc, err := m.Calculator(root)
+	if err != nil {
+		return m.base.zero, err
+	}
+
+	return calc.ComputeRate(rate)
+}
+
+// ComputeRateWithPrecision is the same as ComputeRate, but the result will have the provided number of decimal places.
+// See Calculator.ComputeRateWithPrecision for details.
+func (m *MultiRootCalculator[Decimal]) ComputeRateWithPrecision(root uint64, rate Decimal, precision uint64) (Decimal, error) {
+	calc, err := m.Calculator(root)
+	if err != nil {
+		return m.base.zero, err
+	}
+
+	return calc.ComputeRateWithPrecision(rate, precision)
+}
+
+// Calculator returns the Calculator for the provided root, building it if it wasn't requested before.
+func (m *MultiRootCalculator[Decimal]) Calculator(root uint64) (*Calculator[Decimal], error) {
+	if root < minRoot {
+		return nil, ErrConfigRootMinValue
+	}
+
+	m.mu.Lock()
+
+	entry, ok := m.calculators[root]
+	if !ok {
+		entry = &multiRootEntry[Decimal]{}
+		m.calculators[root] = entry
+	}
+
+	m.mu.Unlock()
+
+	entry.once.Do(func() {
+		cfg := m.cfg
+		cfg.Root = root
+
+		entry.err = validateTermsLimit(cfg)
+		if entry.err == nil {
+			entry.calc, entry.err = newCalculatorFromBase(context.Background(), cfg, m.base, options[Decimal]{})
+		}
+
+		if entry.err != nil {
+			entry.err = fmt.Errorf("building calculator for root %d: %w", root, entry.err)
+		}
+	})
+
+	return entry.calc, entry.err
+}
@@ -0,0 +1,17 @@
+package tsratecalc
+
+import "time"
+
+// Observer receives the Calculator events, e.g. to export metrics. See Config.Observer.
+//
+// The methods are called synchronously, possibly from many goroutines, so they should be fast and safe for concurrent use.
+type Observer interface {
+	// OnBuild is called after a Calculator is built, with the number of Taylor terms computed by the build.
+	// It's zero for lazy caches (see Config.LazyTermsCache), since their terms are computed on demand.
+	OnBuild(duration time.Duration, terms int)
+	// OnCompute is called after each ComputeRate (or ComputeRateWithPrecision) call, with the number of Taylor terms used.
+	//
+	// The error could be checked with errors.Is: ErrRateOutsideConvergenceBoundaries for rates outside the
+	// convergence boundaries, and ErrRateNotConverged for rates that could not converge to the desired precision.
+	OnCompute(duration time.Duration, termsUsed int, err error)
+}
@@ -0,0 +1,40 @@
+package tsratecalc
+
+// Option customizes the Calculator construction on NewCalculatorContext.
+type Option[Decimal Operator[Decimal]] func(o *options[Decimal])
+
+// options stores the values customized by Option.
+type options[Decimal Operator[Decimal]] struct {
+	// progress is called after each Taylor term is computed.
+	progress func(p Progress[Decimal])
+}
+
+// Progress reports the Taylor terms cache construction progress.
+type Progress[Decimal Operator[Decimal]] struct {
+	// Term is the index of the last computed Taylor term, starting at 1.
+	Term uint64
+	// LowerBoundaryError is the error on the lower convergence boundary with the computed terms.
+	LowerBoundaryError Decimal
+	// UpperBoundaryError is the error on the upper convergence boundary with the computed terms.
+	UpperBoundaryError Decimal
+}
+
+// WithProgress sets a function to be called after each Taylor term is computed by NewCalculatorContext.
+// The construction ends when both boundary errors are lower than the maximum error implied by Config.Precision.
+//
+// It's called synchronously, so it should be fast.
+func WithProgress[Decimal Operator[Decimal]](fn func(p Progress[Decimal])) Option[Decimal] {
+	return func(o *options[Decimal]) {
+		o.progress = fn
+	}
+}
+
+func newOptions[Decimal Operator[Decimal]](opts []Option[Decimal]) options[Decimal] {
+	var o options[Decimal]
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
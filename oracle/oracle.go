@@ -0,0 +1,198 @@
+// Package oracle computes "(1+x)^(1/c) - 1" exactly, to any number of decimal places.
+//
+// It's much slower than tsratecalc.Calculator, and is meant to be used as the ground truth on tests and verifications.
+// The computation uses an integer n-th root over the scaled value of "1+x", so no rounding happens until the
+// requested decimal place.
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// maxGuardPlaces is the maximum number of extra decimal places used by WithinULP to decide ambiguous cases.
+const maxGuardPlaces = 120
+
+var (
+	ErrRootZero             = errors.New("root should be positive")
+	ErrRateNotAboveMinusOne = errors.New("rate should be greater than -1")
+	ErrInvalidDecimal       = errors.New("invalid decimal string")
+)
+
+// ParseRat parses a decimal string representation (e.g. the String() of a tsratecalc.Operator) into a rational number.
+func ParseRat(s string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrInvalidDecimal, s)
+	}
+
+	return r, nil
+}
+
+// Rate returns "(1+rate)^(1/root) - 1" truncated (i.e. rounded towards zero) to the provided number of decimal places.
+func Rate(rate *big.Rat, root uint64, places uint64) (*big.Rat, error) {
+	floor, exact, err := rateFloor(rate, root, places)
+	if err != nil {
+		return nil, err
+	}
+
+	// Negative values are truncated towards zero, so the floor should be moved up by one unit.
+	if floor.Sign() < 0 && !exact {
+		floor.Add(floor, new(big.Rat).SetFrac(big.NewInt(1), pow10(places)))
+	}
+
+	return floor, nil
+}
+
+// RateString is the same as Rate, but receives and returns decimal strings.
+// The result has exactly the provided number of decimal places.
+func RateString(rate string, root uint64, places uint64) (string, error) {
+	r, err := ParseRat(rate)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := Rate(r, root, places)
+	if err != nil {
+		return "", err
+	}
+
+	return res.FloatString(int(places)), nil
+}
+
+// WithinULP reports whether result is closer than 10^(-places) to the exact value of "(1+rate)^(1/root) - 1".
+//
+// It's the maximum distance between the exact value and any approximation that was truncated to the provided
+// number of decimal places after being computed with an error lower than 10^(-places)/2.
+func WithinULP(rate, result *big.Rat, root uint64, places uint64) (bool, error) {
+	ulp := new(big.Rat).SetFrac(big.NewInt(1), pow10(places))
+
+	resultPlusULP := new(big.Rat).Add(result, ulp)
+	resultMinusULP := new(big.Rat).Sub(result, ulp)
+
+	// The exact value is computed with extra places, until it's possible to decide whether it's inside
+	// the (result - ulp, result + ulp) interval.
+	for guard := uint64(5); ; guard *= 2 {
+		if guard > maxGuardPlaces {
+			guard = maxGuardPlaces
+		}
+
+		lower, exact, err := rateFloor(rate, root, places+guard)
+		if err != nil {
+			return false, err
+		}
+
+		if exact {
+			return lower.Cmp(resultMinusULP) > 0 && lower.Cmp(resultPlusULP) < 0, nil
+		}
+
+		// The exact value lies in (lower, upper).
+		upper := new(big.Rat).Add(lower, new(big.Rat).SetFrac(big.NewInt(1), pow10(places+guard)))
+
+		if upper.Cmp(resultMinusULP) <= 0 || lower.Cmp(resultPlusULP) >= 0 {
+			return false, nil
+		}
+
+		if lower.Cmp(resultMinusULP) >= 0 && upper.Cmp(resultPlusULP) <= 0 {
+			return true, nil
+		}
+
+		if guard == maxGuardPlaces {
+			return false, fmt.Errorf("could not decide whether '%s' is within 10^(-%d) of the exact value with %d extra places",
+				result.FloatString(int(places)), places, maxGuardPlaces)
+		}
+	}
+}
+
+// rateFloor returns the largest multiple of 10^(-places) that is lower than or equal to "(1+rate)^(1/root) - 1",
+// and whether it's equal to the exact value.
+func rateFloor(rate *big.Rat, root uint64, places uint64) (*big.Rat, bool, error) {
+	if root == 0 {
+		return nil, false, ErrRootZero
+	}
+
+	base := new(big.Rat).Add(rate, big.NewRat(1, 1))
+	if base.Sign() <= 0 {
+		return nil, false, fmt.Errorf("%w: got '%s'", ErrRateNotAboveMinusOne, rate.RatString())
+	}
+
+	// floor(10^places * base^(1/root)) = iroot(floor(base * 10^(places*root)), root)
+	scaled, remainder := new(big.Int).QuoRem(
+		new(big.Int).Mul(base.Num(), pow10(places*root)),
+		base.Denom(),
+		new(big.Int),
+	)
+
+	floorRoot := integerRoot(scaled, root)
+
+	exact := remainder.Sign() == 0 && new(big.Int).Exp(floorRoot, new(big.Int).SetUint64(root), nil).Cmp(scaled) == 0
+
+	res := new(big.Rat).SetFrac(floorRoot, pow10(places))
+
+	return res.Sub(res, big.NewRat(1, 1)), exact, nil
+}
+
+// integerRoot returns floor(n^(1/k)) for a non-negative n.
+func integerRoot(n *big.Int, k uint64) *big.Int {
+	if n.Sign() == 0 || k == 1 {
+		return new(big.Int).Set(n)
+	}
+
+	// An initial guess with float64 precision, slightly above the real root, so the Newton's method below
+	// converges quadratically and monotonically decreasing.
+	mant := new(big.Float).SetInt(n)
+	exp := mant.MantExp(mant)
+	m, _ := mant.Float64()
+
+	q, r := exp/int(k), exp%int(k)
+	guessFloat := new(big.Float).SetFloat64(math.Pow(m, 1/float64(k)) * math.Pow(2, float64(r)/float64(k)) * (1 + 1e-9))
+	guessFloat.SetMantExp(guessFloat, q)
+
+	x, _ := guessFloat.Int(nil)
+	x.Add(x, big.NewInt(1))
+
+	var (
+		kBig   = new(big.Int).SetUint64(k)
+		kMinus = new(big.Int).SetUint64(k - 1)
+		y      = new(big.Int)
+		t      = new(big.Int)
+	)
+
+	for {
+		// y = ((k-1)*x + n / x^(k-1)) / k
+		t.Exp(x, kMinus, nil)
+		t.Quo(n, t)
+		y.Mul(kMinus, x)
+		y.Add(y, t)
+		y.Quo(y, kBig)
+
+		if y.Cmp(x) >= 0 {
+			break
+		}
+
+		x.Set(y)
+	}
+
+	// Guarding against an initial guess below the real root.
+	for t.Exp(x, kBig, nil).Cmp(n) > 0 {
+		x.Sub(x, big.NewInt(1))
+	}
+
+	for {
+		y.Add(x, big.NewInt(1))
+		if t.Exp(y, kBig, nil).Cmp(n) > 0 {
+			break
+		}
+
+		x.Set(y)
+	}
+
+	return x
+}
+
+// pow10 returns 10^n as an integer.
+func pow10(n uint64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(n), nil)
+}
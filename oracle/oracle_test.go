@@ -0,0 +1,131 @@
+package oracle_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/mqzabin/tsratecalc/oracle"
+)
+
+func TestRateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		rate    string
+		root    uint64
+		places  uint64
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "exact square root",
+			rate:   "0.21",
+			root:   2,
+			places: 10,
+			want:   "0.1000000000",
+		},
+		{
+			name:   "exact negative square root",
+			rate:   "-0.4375",
+			root:   2,
+			places: 4,
+			want:   "-0.2500",
+		},
+		{
+			name:   "business days root",
+			rate:   "0.1",
+			root:   252,
+			places: 30,
+			want:   "0.000378286531534243543770101243",
+		},
+		{
+			name:   "negative rates are truncated towards zero",
+			rate:   "-0.1",
+			root:   252,
+			places: 30,
+			want:   "-0.000418009893866526958510699547",
+		},
+		{
+			name:   "zero rate",
+			rate:   "0",
+			root:   365,
+			places: 5,
+			want:   "0.00000",
+		},
+		{
+			name:    "zero root",
+			rate:    "0.1",
+			root:    0,
+			places:  5,
+			wantErr: oracle.ErrRootZero,
+		},
+		{
+			name:    "rate equal to -1",
+			rate:    "-1",
+			root:    2,
+			places:  5,
+			wantErr: oracle.ErrRateNotAboveMinusOne,
+		},
+		{
+			name:    "invalid decimal",
+			rate:    "0.1.2",
+			root:    2,
+			places:  5,
+			wantErr: oracle.ErrInvalidDecimal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := oracle.RateString(tc.rate, tc.root, tc.places)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("unexpected error: got %v, want %v", err, tc.wantErr)
+			}
+
+			if got != tc.want {
+				t.Fatalf("unexpected result: got '%s', want '%s'", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWithinULP(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		result string
+		want   bool
+	}{
+		{name: "truncated value", result: "0.0003782865", want: true},
+		{name: "one unit above", result: "0.0003782866", want: true},
+		{name: "one unit below", result: "0.0003782864", want: false},
+		{name: "two units above", result: "0.0003782867", want: false},
+	}
+
+	rate := big.NewRat(1, 10)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := oracle.ParseRat(tc.result)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			got, err := oracle.WithinULP(rate, result, 252, 10)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if got != tc.want {
+				t.Fatalf("unexpected result: got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
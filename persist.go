@@ -0,0 +1,505 @@
+package tsratecalc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+)
+
+const (
+	// termsCacheFormatVersion is the current version of the persisted Taylor terms cache format.
+	// Version 2 added the guard digits to the Config fingerprint, and version 3 added the precision mode.
+	termsCacheFormatVersion = 3
+	// termsCacheMagic identifies the binary format of a persisted Taylor terms cache.
+	termsCacheMagic = "TSRC"
+)
+
+var (
+	ErrCacheInvalidFormat       = errors.New("invalid taylor terms cache format")
+	ErrCacheUnsupportedVersion  = errors.New("unsupported taylor terms cache version")
+	ErrCacheChecksumMismatch    = errors.New("taylor terms cache checksum mismatch")
+	ErrCacheFingerprintMismatch = errors.New("taylor terms cache was built with a different config")
+	ErrCacheInconsistent        = errors.New("taylor terms cache is inconsistent with the calculator")
+	ErrCacheNotConverged        = errors.New("taylor terms cache doesn't converge on the convergence boundaries")
+)
+
+// configFingerprint identifies the Config fields that define the Taylor terms cache content.
+type configFingerprint struct {
+	Root              uint64 `json:"root"`
+	Precision         uint64 `json:"precision"`
+	ConvergenceRadius string `json:"convergenceRadius"`
+	MaxTermsCache     uint64 `json:"maxTermsCache"`
+	GuardDigits       uint64 `json:"guardDigits"`
+	PrecisionMode     uint64 `json:"precisionMode"`
+}
+
+func newConfigFingerprint[Decimal Operator[Decimal]](cfg Config[Decimal]) configFingerprint {
+	return configFingerprint{
+		Root:              cfg.Root,
+		Precision:         cfg.Precision,
+		ConvergenceRadius: cfg.ConvergenceRadius.String(),
+		MaxTermsCache:     cfg.MaxTermsCache,
+		GuardDigits:       cfg.GuardDigits,
+		PrecisionMode:     uint64(cfg.PrecisionMode),
+	}
+}
+
+func (f configFingerprint) String() string {
+	return fmt.Sprintf(
+		"root=%d precision=%d radius=%s maxTermsCache=%d guardDigits=%d precisionMode=%s",
+		f.Root, f.Precision, f.ConvergenceRadius, f.MaxTermsCache, f.GuardDigits, PrecisionMode(f.PrecisionMode),
+	)
+}
+
+// termsSnapshot is the persisted content of a Taylor terms cache, with every decimal in its String() representation.
+type termsSnapshot struct {
+	Fingerprint   configFingerprint `json:"fingerprint"`
+	MaxError      string            `json:"maxError"`
+	LowerBoundary string            `json:"lowerBoundary"`
+	UpperBoundary string            `json:"upperBoundary"`
+	Terms         []string          `json:"terms"`
+}
+
+// termsSnapshotJSON is the JSON form of a persisted Taylor terms cache.
+type termsSnapshotJSON struct {
+	Version uint16 `json:"version"`
+	termsSnapshot
+	// Checksum is the CRC-32 (IEEE) of the snapshot binary payload.
+	Checksum uint32 `json:"checksum"`
+}
+
+// appendPayload appends the binary encoding of the snapshot, without header and checksum.
+func (s termsSnapshot) appendPayload(b []byte) []byte {
+	appendString := func(b []byte, v string) []byte {
+		b = binary.AppendUvarint(b, uint64(len(v)))
+
+		return append(b, v...)
+	}
+
+	b = binary.AppendUvarint(b, s.Fingerprint.Root)
+	b = binary.AppendUvarint(b, s.Fingerprint.Precision)
+	b = appendString(b, s.Fingerprint.ConvergenceRadius)
+	b = binary.AppendUvarint(b, s.Fingerprint.MaxTermsCache)
+	b = binary.AppendUvarint(b, s.Fingerprint.GuardDigits)
+	b = binary.AppendUvarint(b, s.Fingerprint.PrecisionMode)
+	b = appendString(b, s.MaxError)
+	b = appendString(b, s.LowerBoundary)
+	b = appendString(b, s.UpperBoundary)
+	b = binary.AppendUvarint(b, uint64(len(s.Terms)))
+
+	for _, term := range s.Terms {
+		b = appendString(b, term)
+	}
+
+	return b
+}
+
+// parsePayload parses the binary encoding of a snapshot, without header and checksum.
+func parsePayload(b []byte) (termsSnapshot, error) {
+	r := bytes.NewReader(b)
+
+	readUvarint := func(name string) (uint64, error) {
+		v, err := binary.ReadUvarint(r)
+		if err != nil {
+			return 0, fmt.Errorf("%w: reading %s: %w", ErrCacheInvalidFormat, name, err)
+		}
+
+		return v, nil
+	}
+
+	readString := func(name string) (string, error) {
+		n, err := readUvarint(name + " length")
+		if err != nil {
+			return "", err
+		}
+
+		if n > uint64(r.Len()) {
+			return "", fmt.Errorf("%w: %s length %d exceeds the remaining %d bytes", ErrCacheInvalidFormat, name, n, r.Len())
+		}
+
+		v := make([]byte, n)
+
+		_, err = r.Read(v)
+		if err != nil {
+			return "", fmt.Errorf("%w: reading %s: %w", ErrCacheInvalidFormat, name, err)
+		}
+
+		return string(v), nil
+	}
+
+	var (
+		s   termsSnapshot
+		err error
+	)
+
+	if s.Fingerprint.Root, err = readUvarint("root"); err != nil {
+		return termsSnapshot{}, err
+	}
+
+	if s.Fingerprint.Precision, err = readUvarint("precision"); err != nil {
+		return termsSnapshot{}, err
+	}
+
+	if s.Fingerprint.ConvergenceRadius, err = readString("convergence radius"); err != nil {
+		return termsSnapshot{}, err
+	}
+
+	if s.Fingerprint.MaxTermsCache, err = readUvarint("max terms cache"); err != nil {
+		return termsSnapshot{}, err
+	}
+
+	if s.Fingerprint.GuardDigits, err = readUvarint("guard digits"); err != nil {
+		return termsSnapshot{}, err
+	}
+
+	if s.Fingerprint.PrecisionMode, err = readUvarint("precision mode"); err != nil {
+		return termsSnapshot{}, err
+	}
+
+	if s.MaxError, err = readString("max error"); err != nil {
+		return termsSnapshot{}, err
+	}
+
+	if s.LowerBoundary, err = readString("lower boundary"); err != nil {
+		return termsSnapshot{}, err
+	}
+
+	if s.UpperBoundary, err = readString("upper boundary"); err != nil {
+		return termsSnapshot{}, err
+	}
+
+	termsLen, err := readUvarint("terms length")
+	if err != nil {
+		return termsSnapshot{}, err
+	}
+
+	// Each term takes at least one byte, so it's possible to check the length before allocating.
+	if termsLen > uint64(r.Len()) {
+		return termsSnapshot{}, fmt.Errorf("%w: terms length %d exceeds the remaining %d bytes", ErrCacheInvalidFormat, termsLen, r.Len())
+	}
+
+	s.Terms = make([]string, 0, termsLen)
+
+	for i := uint64(0); i < termsLen; i++ {
+		term, err := readString(fmt.Sprintf("term %d", i+1))
+		if err != nil {
+			return termsSnapshot{}, err
+		}
+
+		s.Terms = append(s.Terms, term)
+	}
+
+	if r.Len() != 0 {
+		return termsSnapshot{}, fmt.Errorf("%w: %d unexpected trailing bytes", ErrCacheInvalidFormat, r.Len())
+	}
+
+	return s, nil
+}
+
+// MarshalBinary encodes the calculator's Taylor terms cache, so it could be loaded later with UnmarshalBinary or LoadCalculator.
+//
+// The format has a version header and a CRC-32 checksum, and stores the Config fields that define the cache content
+// (i.e. root, precision, convergence radius, maximum terms, guard digits and precision mode), so it can't be loaded by a calculator with a different Config.
+// The derived max error and convergence boundaries are also stored, and should match the ones of the loading calculator.
+func (c *Calculator[Decimal]) MarshalBinary() ([]byte, error) {
+	b := []byte(termsCacheMagic)
+	b = binary.BigEndian.AppendUint16(b, termsCacheFormatVersion)
+	b = c.snapshot().appendPayload(b)
+
+	return binary.BigEndian.AppendUint32(b, crc32.ChecksumIEEE(b)), nil
+}
+
+// UnmarshalBinary replaces the calculator's Taylor terms cache with the one encoded by MarshalBinary.
+//
+// The calculator should be created with a Config providing NewFromString, and equal to the Config of the encoded cache,
+// otherwise ErrCacheFingerprintMismatch is returned. It's usually combined with Config.LazyTermsCache,
+// so NewCalculator doesn't compute the terms that will be replaced. See LoadCalculator.
+func (c *Calculator[Decimal]) UnmarshalBinary(data []byte) error {
+	headerLen := len(termsCacheMagic) + 2
+
+	if len(data) < headerLen+4 || string(data[:len(termsCacheMagic)]) != termsCacheMagic {
+		return ErrCacheInvalidFormat
+	}
+
+	version := binary.BigEndian.Uint16(data[len(termsCacheMagic):headerLen])
+	if version != termsCacheFormatVersion {
+		return fmt.Errorf("%w: got %d, want %d", ErrCacheUnsupportedVersion, version, termsCacheFormatVersion)
+	}
+
+	content, checksum := data[:len(data)-4], binary.BigEndian.Uint32(data[len(data)-4:])
+	if crc32.ChecksumIEEE(content) != checksum {
+		return ErrCacheChecksumMismatch
+	}
+
+	s, err := parsePayload(content[headerLen:])
+	if err != nil {
+		return err
+	}
+
+	return c.restore(s)
+}
+
+// MarshalJSON is the JSON form of MarshalBinary.
+func (c *Calculator[Decimal]) MarshalJSON() ([]byte, error) {
+	s := c.snapshot()
+
+	return json.Marshal(termsSnapshotJSON{
+		Version:       termsCacheFormatVersion,
+		termsSnapshot: s,
+		Checksum:      crc32.ChecksumIEEE(s.appendPayload(nil)),
+	})
+}
+
+// UnmarshalJSON is the JSON form of UnmarshalBinary.
+func (c *Calculator[Decimal]) UnmarshalJSON(data []byte) error {
+	var s termsSnapshotJSON
+
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCacheInvalidFormat, err)
+	}
+
+	if s.Version != termsCacheFormatVersion {
+		return fmt.Errorf("%w: got %d, want %d", ErrCacheUnsupportedVersion, s.Version, termsCacheFormatVersion)
+	}
+
+	if crc32.ChecksumIEEE(s.appendPayload(nil)) != s.Checksum {
+		return ErrCacheChecksumMismatch
+	}
+
+	return c.restore(s.termsSnapshot)
+}
+
+// LoadCalculator returns a new Calculator given a Config, using the Taylor terms cache encoded by Calculator.MarshalBinary,
+// instead of computing it.
+//
+// The Config should provide NewFromString, and be equal to the Config used to build the encoded cache.
+func LoadCalculator[Decimal Operator[Decimal]](cfg Config[Decimal], data []byte) (*Calculator[Decimal], error) {
+	return loadCalculator(cfg, data, (*Calculator[Decimal]).UnmarshalBinary)
+}
+
+// LoadCalculatorJSON is the same as LoadCalculator, but for the cache encoded by Calculator.MarshalJSON.
+func LoadCalculatorJSON[Decimal Operator[Decimal]](cfg Config[Decimal], data []byte) (*Calculator[Decimal], error) {
+	return loadCalculator(cfg, data, (*Calculator[Decimal]).UnmarshalJSON)
+}
+
+// NewCalculatorFromTerms returns a new Calculator given a Config, using the provided Taylor terms
+// (in their String() representation) instead of computing them. It's used by the code generated with cmd/tsratecalc-gen.
+//
+// The Config should provide NewFromString, and the terms should be computed with the same Config.
+func NewCalculatorFromTerms[Decimal Operator[Decimal]](cfg Config[Decimal], terms []string) (*Calculator[Decimal], error) {
+	return loadCalculator(cfg, terms, func(c *Calculator[Decimal], terms []string) error {
+		s := c.snapshot()
+		s.Terms = terms
+
+		return c.restore(s)
+	})
+}
+
+func loadCalculator[Decimal Operator[Decimal], Data any](
+	cfg Config[Decimal],
+	data Data,
+	unmarshal func(c *Calculator[Decimal], data Data) error,
+) (*Calculator[Decimal], error) {
+	if cfg.NewFromString == nil {
+		return nil, ErrConfigNewFromStringIsNil
+	}
+
+	// A lazy cache doesn't compute any term on creation.
+	lazyCfg := cfg
+	lazyCfg.LazyTermsCache = true
+
+	calc, err := NewCalculator(lazyCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = unmarshal(calc, data)
+	if err != nil {
+		return nil, fmt.Errorf("loading taylor terms cache: %w", err)
+	}
+
+	if !cfg.LazyTermsCache {
+		// The cache could be persisted by a lazy calculator, so it's completed like the eager construction would.
+		err = calc.completeTermsCache()
+		if err != nil {
+			return nil, fmt.Errorf("completing taylor terms cache: %w", err)
+		}
+
+		// Expandable calculators keep the lazy cache, so it could grow beyond the initial boundaries.
+		if calc.expansion == nil {
+			calc.taylorTerms = newStaticTermsCache(calc.taylorTerms.load())
+		}
+
+		err = calc.computeThresholds()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return calc, nil
+}
+
+// snapshot returns the persistable content of the calculator's Taylor terms cache.
+func (c *Calculator[Decimal]) snapshot() termsSnapshot {
+	terms := c.taylorTerms.load()
+
+	s := termsSnapshot{
+		Fingerprint:   c.fingerprint,
+		MaxError:      c.maxError.String(),
+		LowerBoundary: c.convergenceLowerBoundary.String(),
+		UpperBoundary: c.convergenceUpperBoundary.String(),
+		Terms:         make([]string, 0, len(terms)),
+	}
+
+	for _, term := range terms {
+		s.Terms = append(s.Terms, term.String())
+	}
+
+	return s
+}
+
+// restore replaces the calculator's Taylor terms cache with the snapshot content.
+func (c *Calculator[Decimal]) restore(s termsSnapshot) error {
+	if c.newFromString == nil {
+		return ErrConfigNewFromStringIsNil
+	}
+
+	if s.Fingerprint != c.fingerprint {
+		return fmt.Errorf("%w: cache config is '%s', calculator config is '%s'", ErrCacheFingerprintMismatch, s.Fingerprint, c.fingerprint)
+	}
+
+	// The derived values should match, otherwise the cache was built by a different algorithm or decimal type.
+	for _, v := range []struct {
+		name, got, want string
+	}{
+		{name: "max error", got: s.MaxError, want: c.maxError.String()},
+		{name: "lower boundary", got: s.LowerBoundary, want: c.convergenceLowerBoundary.String()},
+		{name: "upper boundary", got: s.UpperBoundary, want: c.convergenceUpperBoundary.String()},
+	} {
+		if v.got != v.want {
+			return fmt.Errorf("%w: %s is '%s', calculator has '%s'", ErrCacheInconsistent, v.name, v.got, v.want)
+		}
+	}
+
+	if uint64(len(s.Terms)) > c.fingerprint.MaxTermsCache {
+		return fmt.Errorf("%w: %d terms exceeds the maximum of %d", ErrCacheInconsistent, len(s.Terms), c.fingerprint.MaxTermsCache)
+	}
+
+	terms := make([]Decimal, 0, len(s.Terms))
+
+	for i, str := range s.Terms {
+		term, err := c.newFromString(str)
+		if err != nil {
+			return fmt.Errorf("parsing taylor term %d '%s': %w", i+1, str, err)
+		}
+
+		terms = append(terms, term)
+	}
+
+	// Caches that can't grow should converge on the boundaries, like the eager construction.
+	if !c.taylorTerms.growable() {
+		converged, err := c.boundaryConverged(terms)
+		if err != nil {
+			return err
+		}
+
+		if !converged {
+			return fmt.Errorf("%w: %d terms aren't enough", ErrCacheNotConverged, len(terms))
+		}
+	}
+
+	// The thresholds depend on the terms values, so they're recomputed from the restored ones.
+	// They're computed before replacing the cache, so a failure leaves the calculator unchanged.
+	var thresholds *termThresholds[Decimal]
+
+	if c.thresholds != nil {
+		var err error
+
+		thresholds, err = computeTermThresholds(c.convergenceUpperBoundary, terms, c.maxError, c.precision+c.guardDigits, c.newFromInt)
+		if err != nil {
+			return fmt.Errorf("computing taylor terms thresholds: %w", err)
+		}
+	}
+
+	c.taylorTerms.replace(terms)
+
+	if thresholds != nil {
+		c.thresholds = thresholds
+	}
+
+	return nil
+}
+
+// completeTermsCache grows the Taylor terms cache until it converges on the boundaries (see boundaryConverged).
+func (c *Calculator[Decimal]) completeTermsCache() error {
+	terms := c.taylorTerms.load()
+
+	for {
+		converged, err := c.boundaryConverged(terms)
+		if err != nil {
+			return err
+		}
+
+		if converged {
+			return nil
+		}
+
+		n := uint64(len(terms))
+
+		// The eager construction stops one term before MaxTermsCache.
+		if n+1 >= c.fingerprint.MaxTermsCache {
+			return fmt.Errorf("%w: %d terms aren't enough", ErrCacheNotConverged, n)
+		}
+
+		terms, err = c.taylorTerms.grow(n + 1)
+		if err != nil {
+			return err
+		}
+
+		if uint64(len(terms)) <= n {
+			return fmt.Errorf("%w: %d terms aren't enough", ErrCacheNotConverged, n)
+		}
+	}
+}
+
+// boundaryConverged returns true if the error of the last term on the convergence boundaries is lower than or equal
+// to the max error on the boundaries (see boundaryMaxError), the condition that stops the eager construction of the cache.
+// It's never true for a single term.
+func (c *Calculator[Decimal]) boundaryConverged(terms []Decimal) (bool, error) {
+	n := uint64(len(terms))
+	if n < 2 {
+		return false, nil
+	}
+
+	// Both boundaries have the same absolute value, so the errors are the same.
+	power, err := c.convergenceUpperBoundary.PowInt(n)
+	if err != nil {
+		return false, newOperatorError("PowInt", "computing upper convergence rate variable component x^n", n, err)
+	}
+
+	boundaryError, err := terms[n-1].Mul(power)
+	if err != nil {
+		return false, newOperatorError("Mul", "computing upper boundary error", n, err)
+	}
+
+	boundaryError, err = boundaryError.Abs()
+	if err != nil {
+		return false, newOperatorError("Abs", "computing upper boundary error absolute value", n, err)
+	}
+
+	maxError, err := c.boundaryMaxError(c.firstTermCoefficient)
+	if err != nil {
+		return false, err
+	}
+
+	converged, err := boundaryError.LessThanOrEqual(maxError)
+	if err != nil {
+		return false, newOperatorError("LessThanOrEqual", "checking if upper boundary error is less than max error", n, err)
+	}
+
+	return converged, nil
+}
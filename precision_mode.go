@@ -0,0 +1,146 @@
+package tsratecalc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PrecisionMode defines how Config.Precision is interpreted.
+type PrecisionMode int
+
+const (
+	// DecimalPlaces interprets Config.Precision as the number of decimal places of the result. It's the default.
+	DecimalPlaces PrecisionMode = iota
+	// SignificantDigits interprets Config.Precision as the number of significant digits of the result.
+	//
+	// The number of decimal places is adapted to the magnitude of the first Taylor term (rate/root),
+	// so tiny rates keep the same relative error as large ones. Results smaller than 10^-significantDigitsMaxShift
+	// are limited to Precision+significantDigitsMaxShift decimal places.
+	SignificantDigits
+)
+
+// significantDigitsMaxShift is the maximum number of decimal places added to Config.Precision by the SignificantDigits mode.
+const significantDigitsMaxShift = 64
+
+var ErrConfigPrecisionModeInvalid = errors.New("invalid precision mode")
+
+func (m PrecisionMode) String() string {
+	switch m {
+	case DecimalPlaces:
+		return "decimal places"
+	case SignificantDigits:
+		return "significant digits"
+	default:
+		return fmt.Sprintf("PrecisionMode(%d)", int(m))
+	}
+}
+
+func (m PrecisionMode) valid() bool {
+	return m == DecimalPlaces || m == SignificantDigits
+}
+
+// maxPlaces returns the maximum number of decimal places of a result, given the configured precision.
+func (m PrecisionMode) maxPlaces(precision uint64) uint64 {
+	if m == SignificantDigits {
+		return precision + significantDigitsMaxShift
+	}
+
+	return precision
+}
+
+// decimalDigits returns the number of decimal digits of n.
+func decimalDigits(n uint64) uint64 {
+	digits := uint64(1)
+
+	for n >= 10 {
+		n /= 10
+		digits++
+	}
+
+	return digits
+}
+
+// computeNegativePowersOfTen returns 10^0, 10^-1, ..., 10^-n.
+func computeNegativePowersOfTen[Decimal Operator[Decimal]](n uint64, newFromInt func(n uint64) (Decimal, error)) ([]Decimal, error) {
+	one, err := newFromInt(1)
+	if err != nil {
+		return nil, fmt.Errorf("creating '1' decimal: %w", err)
+	}
+
+	ten, err := newFromInt(10)
+	if err != nil {
+		return nil, fmt.Errorf("creating '10' decimal: %w", err)
+	}
+
+	powers := make([]Decimal, 0, n+1)
+	powers = append(powers, one)
+
+	for k := uint64(1); k <= n; k++ {
+		power, err := powers[k-1].DivRound(ten, k)
+		if err != nil {
+			return nil, fmt.Errorf("computing 10^-%d: %w", k, err)
+		}
+
+		powers = append(powers, power)
+	}
+
+	return powers, nil
+}
+
+// significantPlaces returns the number of decimal places that keeps "digits" significant digits of a value
+// with the magnitude of absValue, limited to [0, digits+significantDigitsMaxShift].
+func significantPlaces[Decimal Operator[Decimal]](absValue Decimal, digits uint64, powersOfTen []Decimal) (uint64, error) {
+	// Searching for the first k where 10^-k <= absValue, so absValue has k-1 leading zeros after the decimal point.
+	lo, hi := 0, len(powersOfTen)
+
+	for lo < hi {
+		mid := (lo + hi) / 2
+
+		ok, err := powersOfTen[mid].LessThanOrEqual(absValue)
+		if err != nil {
+			return 0, fmt.Errorf("comparing value with 10^-%d: %w", mid, err)
+		}
+
+		if ok {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	// Values greater than or equal to 1 keep "digits" decimal places minus one, at least.
+	if lo == 0 {
+		if digits == 0 {
+			return 0, nil
+		}
+
+		return digits - 1, nil
+	}
+
+	return min(digits+uint64(lo)-1, digits+significantDigitsMaxShift), nil
+}
+
+// round truncates the result to the configured precision: the decimal places, or the significant digits
+// on SignificantDigits mode.
+func (c *calculatorBase[Decimal]) round(res Decimal) (Decimal, error) {
+	places := c.precision
+
+	if c.precisionMode == SignificantDigits {
+		abs, err := res.Abs()
+		if err != nil {
+			return c.zero, newOperatorError("Abs", "computing result absolute value", 0, err)
+		}
+
+		places, err = significantPlaces(abs, c.precision, c.powersOfTen)
+		if err != nil {
+			return c.zero, fmt.Errorf("computing result decimal places: %w", err)
+		}
+	}
+
+	res, err := res.Truncate(places)
+	if err != nil {
+		return c.zero, newOperatorError("Truncate", "rounding final result", 0, err)
+	}
+
+	return res, nil
+}
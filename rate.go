@@ -0,0 +1,279 @@
+package tsratecalc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DayCount is the day count convention of a daily Period. Periods with different day counts can't be converted.
+type DayCount int
+
+const (
+	// DayCountNone is used by periods that don't depend on a day count (e.g. annual and monthly). It's the default.
+	DayCountNone DayCount = iota
+	// DayCountActual365 counts every calendar day, with 365 days per year.
+	DayCountActual365
+	// DayCount360 counts 30 days per month, with 360 days per year.
+	DayCount360
+	// DayCountBusiness252 counts business days only, with 252 days per year.
+	DayCountBusiness252
+)
+
+func (d DayCount) String() string {
+	switch d {
+	case DayCountNone:
+		return "none"
+	case DayCountActual365:
+		return "actual/365"
+	case DayCount360:
+		return "30/360"
+	case DayCountBusiness252:
+		return "business/252"
+	default:
+		return fmt.Sprintf("DayCount(%d)", int(d))
+	}
+}
+
+// compatible returns true if periods with the day counts d and other could be converted.
+func (d DayCount) compatible(other DayCount) bool {
+	return d == DayCountNone || other == DayCountNone || d == other
+}
+
+// Period is the period a Rate refers to, e.g. a year or a business day.
+type Period struct {
+	// Name identifies the period, e.g. "annual".
+	Name string
+	// PerYear is the number of periods in a year.
+	PerYear uint64
+	// DayCount is the day count convention of daily periods.
+	DayCount DayCount
+}
+
+var (
+	PeriodAnnual           = Period{Name: "annual", PerYear: 1}
+	PeriodSemiannual       = Period{Name: "semiannual", PerYear: 2}
+	PeriodQuarterly        = Period{Name: "quarterly", PerYear: 4}
+	PeriodMonthly          = Period{Name: "monthly", PerYear: 12}
+	PeriodDaily365         = Period{Name: "daily-365", PerYear: 365, DayCount: DayCountActual365}
+	PeriodDaily360         = Period{Name: "daily-360", PerYear: 360, DayCount: DayCount360}
+	PeriodBusinessDaily252 = Period{Name: "business-daily-252", PerYear: 252, DayCount: DayCountBusiness252}
+)
+
+func (p Period) String() string {
+	if p.Name != "" {
+		return p.Name
+	}
+
+	return fmt.Sprintf("Period(%d per year)", p.PerYear)
+}
+
+// Compounding defines how a Rate accrues over its Period.
+type Compounding int
+
+const (
+	// CompoundingEffective is a rate compounded once per period: "1+rate" over one period is "(1+rate)^n" over n periods.
+	// It's the default.
+	CompoundingEffective Compounding = iota
+	// CompoundingNominal is a rate quoted proportionally to its period: a nominal rate over n periods is "n*rate".
+	CompoundingNominal
+	// CompoundingContinuous is a continuously compounded rate: "e^rate" over one period is "e^(n*rate)" over n periods.
+	CompoundingContinuous
+)
+
+func (c Compounding) String() string {
+	switch c {
+	case CompoundingEffective:
+		return "effective"
+	case CompoundingNominal:
+		return "nominal"
+	case CompoundingContinuous:
+		return "continuous"
+	default:
+		return fmt.Sprintf("Compounding(%d)", int(c))
+	}
+}
+
+func (c Compounding) valid() bool {
+	return c == CompoundingEffective || c == CompoundingNominal || c == CompoundingContinuous
+}
+
+var (
+	ErrPeriodInvalid          = errors.New("period should have a positive number of periods per year")
+	ErrPeriodDayCountMismatch = errors.New("periods with different day counts can't be converted")
+	ErrPeriodRatioNotIntegral = errors.New("effective rates can only be converted between periods with an integer ratio")
+	ErrCompoundingInvalid     = errors.New("invalid compounding")
+	ErrRateWithoutRates       = errors.New("rate should be created by Rates to be converted")
+)
+
+// PeriodConversionError is an error type for rates that can't be converted to the target Period.
+type PeriodConversionError struct {
+	// From is the Period of the rate.
+	From Period
+	// To is the target Period.
+	To Period
+	// Compounding is the Compounding of the rate.
+	Compounding Compounding
+	// Err is the reason, e.g. ErrPeriodDayCountMismatch.
+	Err error
+}
+
+func (e *PeriodConversionError) Error() string {
+	return fmt.Sprintf("converting %s %s rate to %s: %v", e.From, e.Compounding, e.To, e.Err)
+}
+
+func (e *PeriodConversionError) Unwrap() error {
+	return e.Err
+}
+
+// Rates creates rates whose conversions share the same calculators, one for each root required so far.
+// It's safe for concurrent use.
+type Rates[Decimal Operator[Decimal]] struct {
+	calc *MultiRootCalculator[Decimal]
+}
+
+// NewRates returns a new Rates given a Config for a specific Decimal type.
+// The Config.Root is ignored, since the root is defined by each conversion. See NewMultiRootCalculator for details.
+func NewRates[Decimal Operator[Decimal]](cfg Config[Decimal]) (*Rates[Decimal], error) {
+	calc, err := NewMultiRootCalculator(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rates[Decimal]{
+		calc: calc,
+	}, nil
+}
+
+// Rate returns a Rate with the provided value, period and compounding, converted by the Rates calculators.
+func (r *Rates[Decimal]) Rate(value Decimal, period Period, compounding Compounding) Rate[Decimal] {
+	return Rate[Decimal]{
+		Value:       value,
+		Period:      period,
+		Compounding: compounding,
+		rates:       r,
+	}
+}
+
+// Rate is a rate value along with the Period it refers to and its Compounding, so rates of different periods aren't mixed.
+type Rate[Decimal Operator[Decimal]] struct {
+	// Value is the rate over one Period, e.g. 0.1375 for 13.75%.
+	Value Decimal
+	// Period is the period the Value refers to.
+	Period Period
+	// Compounding defines how the Value accrues over many periods.
+	Compounding Compounding
+
+	// rates converts the rate. It's nil if the Rate wasn't created by Rates.
+	rates *Rates[Decimal]
+}
+
+func (r Rate[Decimal]) String() string {
+	return fmt.Sprintf("%s %s %s", r.Value.String(), r.Period, r.Compounding)
+}
+
+// To converts the rate to the target Period, keeping its Compounding. The result has Config.Precision decimal places
+// (or significant digits, on SignificantDigits mode).
+//
+// Effective rates are converted from longer to shorter periods with the root "target.PerYear / r.Period.PerYear"
+// (e.g. annual to business-daily-252 is "(1+rate)^(1/252)-1"), and from shorter to longer periods with the
+// integer power of the inverse ratio. Periods whose ratio isn't an integer (e.g. monthly to daily-365)
+// return PeriodConversionError wrapping ErrPeriodRatioNotIntegral, and should be converted by a Converter instead.
+//
+// Nominal and continuous rates are proportional to the period length, so they're converted by the ratio itself.
+//
+// Periods with different day counts (e.g. daily-365 and business-daily-252) return PeriodConversionError
+// wrapping ErrPeriodDayCountMismatch.
+func (r Rate[Decimal]) To(target Period) (Rate[Decimal], error) {
+	conversionErr := func(err error) error {
+		return &PeriodConversionError{From: r.Period, To: target, Compounding: r.Compounding, Err: err}
+	}
+
+	if r.Period.PerYear == 0 || target.PerYear == 0 {
+		return Rate[Decimal]{}, conversionErr(ErrPeriodInvalid)
+	}
+
+	if !r.Compounding.valid() {
+		return Rate[Decimal]{}, conversionErr(fmt.Errorf("%w: %s", ErrCompoundingInvalid, r.Compounding))
+	}
+
+	if !r.Period.DayCount.compatible(target.DayCount) {
+		return Rate[Decimal]{}, conversionErr(fmt.Errorf("%w: %s and %s", ErrPeriodDayCountMismatch, r.Period.DayCount, target.DayCount))
+	}
+
+	converted := r
+	converted.Period = target
+
+	if r.Period.PerYear == target.PerYear {
+		return converted, nil
+	}
+
+	if r.rates == nil {
+		return Rate[Decimal]{}, ErrRateWithoutRates
+	}
+
+	var err error
+
+	switch {
+	case r.Compounding != CompoundingEffective:
+		converted.Value, err = r.rates.scale(r.Value, r.Period.PerYear, target.PerYear)
+	case target.PerYear%r.Period.PerYear == 0:
+		converted.Value, err = r.rates.calc.ComputeRate(target.PerYear/r.Period.PerYear, r.Value)
+	case r.Period.PerYear%target.PerYear == 0:
+		converted.Value, err = r.rates.compound(r.Value, r.Period.PerYear/target.PerYear)
+	default:
+		return Rate[Decimal]{}, conversionErr(fmt.Errorf("%w: %d/%d", ErrPeriodRatioNotIntegral, target.PerYear, r.Period.PerYear))
+	}
+
+	if err != nil {
+		return Rate[Decimal]{}, fmt.Errorf("converting %s rate to %s: %w", r, target, err)
+	}
+
+	return converted, nil
+}
+
+// scale returns "value * from / to", truncated to the configured precision (see calculatorBase.round).
+func (r *Rates[Decimal]) scale(value Decimal, from, to uint64) (Decimal, error) {
+	cfg := r.calc.cfg
+
+	numerator, err := cfg.NewFromInt(from)
+	if err != nil {
+		return r.calc.base.zero, fmt.Errorf("creating '%d' decimal: %w", from, err)
+	}
+
+	denominator, err := cfg.NewFromInt(to)
+	if err != nil {
+		return r.calc.base.zero, fmt.Errorf("creating '%d' decimal: %w", to, err)
+	}
+
+	res, err := value.Mul(numerator)
+	if err != nil {
+		return r.calc.base.zero, newOperatorError("Mul", "scaling rate", 0, err)
+	}
+
+	res, err = res.DivRound(denominator, cfg.PrecisionMode.maxPlaces(cfg.Precision)+cfg.GuardDigits)
+	if err != nil {
+		return r.calc.base.zero, newOperatorError("DivRound", "scaling rate", 0, err)
+	}
+
+	return r.calc.base.round(res)
+}
+
+// compound returns "(1+value)^n - 1", truncated to the configured precision (see calculatorBase.round).
+func (r *Rates[Decimal]) compound(value Decimal, n uint64) (Decimal, error) {
+	res, err := r.calc.base.one.Add(value)
+	if err != nil {
+		return r.calc.base.zero, newOperatorError("Add", "computing 1+rate", 0, err)
+	}
+
+	res, err = res.PowInt(n)
+	if err != nil {
+		return r.calc.base.zero, newOperatorError("PowInt", "computing (1+rate)^n", 0, err)
+	}
+
+	res, err = res.Sub(r.calc.base.one)
+	if err != nil {
+		return r.calc.base.zero, newOperatorError("Sub", "computing (1+rate)^n-1", 0, err)
+	}
+
+	return r.calc.base.round(res)
+}
@@ -0,0 +1,171 @@
+package tsratecalc
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Registry stores calculators by their canonical Config, so identical Configs share the same Calculator.
+//
+// Each Calculator is built exactly once, even under concurrent demand: concurrent Get calls with the same Config
+// wait for a single build and receive its result. Failed builds aren't stored, so the next Get call retries.
+// It's safe for concurrent use.
+type Registry[Decimal Operator[Decimal]] struct {
+	mu sync.Mutex
+	// entries stores the calculators built (or being built) so far.
+	entries map[registryKey]*registryEntry[Decimal]
+
+	hits        atomic.Uint64
+	misses      atomic.Uint64
+	buildErrors atomic.Uint64
+	evictions   atomic.Uint64
+	// buildTime is the total time spent building calculators, in nanoseconds.
+	buildTime atomic.Int64
+}
+
+// registryKey is the canonical form of a validated Config.
+type registryKey struct {
+	configFingerprint
+	// lazyTermsCache, verify, evaluation and expandRadius don't change the Taylor terms, but change the Calculator behavior.
+	lazyTermsCache bool
+	verify         bool
+	evaluation     Evaluation
+	expandRadius   bool
+}
+
+// registryEntry stores a Calculator built once.
+type registryEntry[Decimal Operator[Decimal]] struct {
+	once sync.Once
+	calc *Calculator[Decimal]
+	err  error
+}
+
+// RegistryMetrics is a snapshot of the Registry counters.
+type RegistryMetrics struct {
+	// Hits is the number of Get calls that found a Calculator built, or being built, by a previous call.
+	Hits uint64
+	// Misses is the number of Get calls that started a Calculator build.
+	Misses uint64
+	// BuildErrors is the number of builds that returned an error.
+	BuildErrors uint64
+	// Evictions is the number of calculators removed by Evict.
+	Evictions uint64
+	// BuildTime is the total time spent building calculators.
+	BuildTime time.Duration
+	// Entries is the number of calculators currently stored.
+	Entries int
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry[Decimal Operator[Decimal]]() *Registry[Decimal] {
+	return &Registry[Decimal]{
+		entries: make(map[registryKey]*registryEntry[Decimal]),
+	}
+}
+
+// Get returns the Calculator for the provided Config, building it if there's none for an equivalent Config.
+//
+// Configs are equivalent when they have the same root, precision, convergence radius String() representation,
+// maximum terms cache (after applying DefaultMaxTermsCache), LazyTermsCache, Verify, Evaluation and AutoRadius.Expand values.
+// With AutoRadius, the derived convergence radius is compared.
+func (r *Registry[Decimal]) Get(cfg Config[Decimal]) (*Calculator[Decimal], error) {
+	cfg, err := validateConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating config: %w", err)
+	}
+
+	key := newRegistryKey(cfg)
+
+	r.mu.Lock()
+
+	entry, ok := r.entries[key]
+	if !ok {
+		entry = &registryEntry[Decimal]{}
+		r.entries[key] = entry
+	}
+
+	r.mu.Unlock()
+
+	if ok {
+		r.hits.Add(1)
+	} else {
+		r.misses.Add(1)
+	}
+
+	entry.once.Do(func() {
+		start := time.Now()
+
+		entry.calc, entry.err = NewCalculator(cfg)
+
+		r.buildTime.Add(int64(time.Since(start)))
+
+		if entry.err != nil {
+			r.buildErrors.Add(1)
+
+			// Removing the failed entry, so the next call retries the build.
+			r.mu.Lock()
+			if r.entries[key] == entry {
+				delete(r.entries, key)
+			}
+			r.mu.Unlock()
+		}
+	})
+
+	return entry.calc, entry.err
+}
+
+// Evict removes the Calculator for the provided Config, returning false if there's none.
+// Calculators already returned by Get are still usable, and a build in progress isn't interrupted.
+func (r *Registry[Decimal]) Evict(cfg Config[Decimal]) bool {
+	cfg, err := validateConfig(cfg)
+	if err != nil {
+		return false
+	}
+
+	key := newRegistryKey(cfg)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.entries[key]; !ok {
+		return false
+	}
+
+	delete(r.entries, key)
+	r.evictions.Add(1)
+
+	return true
+}
+
+// Len returns the number of calculators currently stored.
+func (r *Registry[Decimal]) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.entries)
+}
+
+// Metrics returns a snapshot of the Registry counters.
+func (r *Registry[Decimal]) Metrics() RegistryMetrics {
+	return RegistryMetrics{
+		Hits:        r.hits.Load(),
+		Misses:      r.misses.Load(),
+		BuildErrors: r.buildErrors.Load(),
+		Evictions:   r.evictions.Load(),
+		BuildTime:   time.Duration(r.buildTime.Load()),
+		Entries:     r.Len(),
+	}
+}
+
+// newRegistryKey returns the canonical key of a validated Config.
+func newRegistryKey[Decimal Operator[Decimal]](cfg Config[Decimal]) registryKey {
+	return registryKey{
+		configFingerprint: newConfigFingerprint(cfg),
+		lazyTermsCache:    cfg.LazyTermsCache,
+		verify:            cfg.Verify,
+		evaluation:        cfg.Evaluation,
+		expandRadius:      cfg.AutoRadius != nil && cfg.AutoRadius.Expand,
+	}
+}
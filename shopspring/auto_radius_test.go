@@ -0,0 +1,293 @@
+package shopspring_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+func TestNewCalculator_AutoRadius(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		autoRadius shopspring.AutoRadius
+		want       string
+		wantErr    error
+	}{
+		{
+			name: "domain",
+			autoRadius: shopspring.AutoRadius{
+				Rates: []decimal.Decimal{decimal.RequireFromString("-0.05"), decimal.RequireFromString("0.2")},
+			},
+			want: "0.22",
+		},
+		{
+			name: "sample",
+			autoRadius: shopspring.AutoRadius{
+				Rates: []decimal.Decimal{
+					decimal.RequireFromString("0.01"),
+					decimal.RequireFromString("-0.3"),
+					decimal.RequireFromString("0.1375"),
+				},
+			},
+			want: "0.33",
+		},
+		{
+			name: "margin",
+			autoRadius: shopspring.AutoRadius{
+				Rates:         []decimal.Decimal{decimal.RequireFromString("0.2")},
+				MarginPercent: 50,
+			},
+			want: "0.3",
+		},
+		{
+			name:       "empty rates",
+			autoRadius: shopspring.AutoRadius{},
+			wantErr:    tsratecalc.ErrAutoRadiusRatesEmpty,
+		},
+		{
+			name: "negative margin",
+			autoRadius: shopspring.AutoRadius{
+				Rates:         []decimal.Decimal{decimal.RequireFromString("0.2")},
+				MarginPercent: -1,
+			},
+			wantErr: shopspring.ErrMarginPercentNegative,
+		},
+		{
+			name: "diverging",
+			autoRadius: shopspring.AutoRadius{
+				Rates: []decimal.Decimal{decimal.RequireFromString("1.01")},
+			},
+			wantErr: tsratecalc.ErrConfigTermsExceedMaxTermsCache,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			calc, err := shopspring.NewCalculator(shopspring.Config{
+				Root:       252,
+				Precision:  30,
+				AutoRadius: &tc.autoRadius,
+			})
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if got := calc.ConvergenceRadius(); !got.Equal(decimal.RequireFromString(tc.want)) {
+				t.Fatalf("unexpected convergence radius: got '%s', want '%s'", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestCalculator_ComputeRate_AutoRadiusExpand(t *testing.T) {
+	t.Parallel()
+
+	// The expanded radius differs from the reference one, so the results only match with more than a single guard digit.
+	reference, err := shopspring.NewCalculator(shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.RequireFromString("0.9"),
+		AutoGuardDigits:   true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	for _, lazy := range []bool{false, true} {
+		cfg := shopspring.Config{
+			Root:      252,
+			Precision: 30,
+			AutoRadius: &shopspring.AutoRadius{
+				Rates: []decimal.Decimal{decimal.RequireFromString("-0.01"), decimal.RequireFromString("0.01")},
+			},
+			LazyTermsCache:  lazy,
+			AutoGuardDigits: true,
+		}
+
+		calc, err := shopspring.NewCalculator(cfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		_, err = calc.ComputeRate(decimal.RequireFromString("0.5"))
+		if !errors.Is(err, tsratecalc.ErrRateOutsideConvergenceBoundaries) {
+			t.Fatalf("unexpected error without expansion: %v", err)
+		}
+
+		cfg.AutoRadius.Expand = true
+
+		calc, err = shopspring.NewCalculator(cfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		rates := []string{"0.005", "-0.5", "0.5", "0.1375", "0.8", "-0.8", "-0.009"}
+
+		var wg sync.WaitGroup
+
+		for _, rate := range rates {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				r := decimal.RequireFromString(rate)
+
+				got, err := calc.ComputeRate(r)
+				if err != nil {
+					t.Errorf("unexpected error for rate '%s': %s", rate, err.Error())
+
+					return
+				}
+
+				want, err := reference.ComputeRate(r)
+				if err != nil {
+					t.Errorf("unexpected error: %s", err.Error())
+
+					return
+				}
+
+				if !got.Equal(want) {
+					t.Errorf("unexpected result for rate '%s' (lazy=%t): got '%s', want '%s'", rate, lazy, got.String(), want.String())
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		if got := calc.ConvergenceRadius(); !got.Equal(decimal.RequireFromString("0.88")) {
+			t.Fatalf("unexpected expanded convergence radius: got '%s', want '0.88'", got.String())
+		}
+
+		// Even the rate absolute value would diverge.
+		_, err = calc.ComputeRate(decimal.RequireFromString("1.5"))
+		if !errors.Is(err, tsratecalc.ErrRateOutsideConvergenceBoundaries) || !errors.Is(err, tsratecalc.ErrConfigTermsExceedMaxTermsCache) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestNewCalculator_AutoRadiusNearOne(t *testing.T) {
+	t.Parallel()
+
+	// The rates near the max radius are only exact with more than a single guard digit.
+	cfg := shopspring.Config{
+		Root:            12,
+		Precision:       10,
+		MaxTermsCache:   1000,
+		AutoGuardDigits: true,
+	}
+
+	// The largest radius that fits, as reported by the terms limit error of a radius that doesn't.
+	limitCfg := cfg
+	limitCfg.ConvergenceRadius = decimal.RequireFromString("0.9999999")
+
+	var limitErr *tsratecalc.TermsLimitError
+
+	if _, err := shopspring.NewCalculator(limitCfg); !errors.As(err, &limitErr) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	maxRadius := decimal.RequireFromString(limitErr.MaxConvergenceRadius)
+
+	if maxRadius.LessThanOrEqual(decimal.RequireFromString("0.95")) || maxRadius.GreaterThanOrEqual(decimal.RequireFromString("1.045")) {
+		t.Fatalf("unexpected max radius: %s", maxRadius.String())
+	}
+
+	testCases := []struct {
+		name  string
+		rates []string
+		// want is the expected radius, or empty for the max radius.
+		want string
+		// wantErr is expected when even the largest |rate| doesn't fit.
+		wantErr bool
+	}{
+		{name: "margin fits", rates: []string{"0.5", "-0.2"}, want: "0.55"},
+		{name: "margin capped", rates: []string{"0.95", "-0.2"}},
+		{name: "rate above the max radius", rates: []string{limitErr.ConvergenceRadius}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			autoRadius := shopspring.AutoRadius{}
+			for _, rate := range tc.rates {
+				autoRadius.Rates = append(autoRadius.Rates, decimal.RequireFromString(rate))
+			}
+
+			autoCfg := cfg
+			autoCfg.AutoRadius = &autoRadius
+
+			calc, err := shopspring.NewCalculator(autoCfg)
+			if tc.wantErr {
+				if !errors.Is(err, tsratecalc.ErrConfigTermsExceedMaxTermsCache) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			want := maxRadius
+			if tc.want != "" {
+				want = decimal.RequireFromString(tc.want)
+			}
+
+			if got := calc.ConvergenceRadius(); !got.Equal(want) {
+				t.Fatalf("unexpected convergence radius: got '%s', want '%s'", got.String(), want.String())
+			}
+
+			for _, rate := range tc.rates {
+				if _, err := calc.Verify(decimal.RequireFromString(rate)); err != nil {
+					t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+				}
+			}
+		})
+	}
+
+	t.Run("expand", func(t *testing.T) {
+		t.Parallel()
+
+		expandCfg := cfg
+		expandCfg.AutoRadius = &shopspring.AutoRadius{
+			Rates:  []decimal.Decimal{decimal.RequireFromString("0.01")},
+			Expand: true,
+		}
+
+		calc, err := shopspring.NewCalculator(expandCfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		for _, rate := range []string{"0.95", "-0.95"} {
+			if _, err := calc.Verify(decimal.RequireFromString(rate)); err != nil {
+				t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+			}
+		}
+
+		if got := calc.ConvergenceRadius(); !got.Equal(maxRadius) {
+			t.Fatalf("unexpected expanded convergence radius: got '%s', want '%s'", got.String(), maxRadius.String())
+		}
+	})
+}
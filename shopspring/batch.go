@@ -0,0 +1,27 @@
+package shopspring
+
+import (
+	"context"
+
+	shopspring "github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+)
+
+// ComputeRates computes every rate like ComputeRate, in parallel, returning the results in the same order of the rates.
+// See tsratecalc.Calculator.ComputeRates for details about the returned errors.
+func (c *Calculator) ComputeRates(ctx context.Context, rates []shopspring.Decimal, opts tsratecalc.BatchOptions) ([]shopspring.Decimal, []error, error) {
+	ds := make([]decimal, len(rates))
+	for i, rate := range rates {
+		ds[i] = decimal{d: rate}
+	}
+
+	results, errs, err := c.calc.ComputeRates(ctx, ds, opts)
+
+	out := make([]shopspring.Decimal, len(results))
+	for i, res := range results {
+		out[i] = res.d
+	}
+
+	return out, translateErrors(errs), err
+}
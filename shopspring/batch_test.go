@@ -0,0 +1,89 @@
+package shopspring_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+func TestCalculator_ComputeRates(t *testing.T) {
+	t.Parallel()
+
+	calc, err := shopspring.NewCalculator(shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.New(9, -1),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	rates := make([]decimal.Decimal, 0, 200)
+	for i := range 200 {
+		rates = append(rates, decimal.New(int64(i-100), -2))
+	}
+
+	// An invalid rate in the middle of the batch shouldn't stop the others.
+	rates[50] = decimal.New(1, 0)
+
+	for _, workers := range []int{0, 1, 7} {
+		results, errs, err := calc.ComputeRates(context.Background(), rates, tsratecalc.BatchOptions{Workers: workers})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if len(results) != len(rates) || len(errs) != len(rates) {
+			t.Fatalf("unexpected lengths: got %d results and %d errors, want %d", len(results), len(errs), len(rates))
+		}
+
+		for i, rate := range rates {
+			want, wantErr := calc.ComputeRate(rate)
+
+			if (errs[i] == nil) != (wantErr == nil) || (wantErr != nil && errs[i].Error() != wantErr.Error()) {
+				t.Fatalf("unexpected error for rate '%s': got %v, want %v", rate, errs[i], wantErr)
+			}
+
+			if !results[i].Equal(want) {
+				t.Fatalf("unexpected result for rate '%s' with %d workers: got '%s', want '%s'", rate, workers, results[i], want)
+			}
+		}
+
+		if !errors.Is(errs[50], tsratecalc.ErrRateOutsideConvergenceBoundaries) {
+			t.Fatalf("unexpected error for rate '%s': %v", rates[50], errs[50])
+		}
+	}
+}
+
+func TestCalculator_ComputeRates_Canceled(t *testing.T) {
+	t.Parallel()
+
+	calc, err := shopspring.NewCalculator(shopspring.Config{
+		Root:              252,
+		Precision:         10,
+		ConvergenceRadius: decimal.New(9, -1),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	rates := []decimal.Decimal{decimal.New(1, -1), decimal.New(-1, -1)}
+
+	results, errs, err := calc.ComputeRates(ctx, rates, tsratecalc.BatchOptions{Workers: 2})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+
+	for i := range rates {
+		if !errors.Is(errs[i], context.Canceled) || !results[i].IsZero() {
+			t.Fatalf("unexpected result for rate '%s': got '%s' and %v", rates[i], results[i], errs[i])
+		}
+	}
+}
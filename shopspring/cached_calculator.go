@@ -0,0 +1,41 @@
+package shopspring
+
+import (
+	shopspring "github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+)
+
+// CachedCalculator is a wrapper around tsratecalc.CachedCalculator for "github.com/shopspring/decimal".Decimal type.
+// It memoizes the ComputeRate results in a bounded LRU, keyed by the rate String() representation.
+type CachedCalculator struct {
+	calc *tsratecalc.CachedCalculator[decimal]
+}
+
+// NewCachedCalculator returns a CachedCalculator that stores at most capacity results of the provided Calculator.
+func NewCachedCalculator(calc *Calculator, capacity int) (*CachedCalculator, error) {
+	cached, err := tsratecalc.NewCachedCalculator(calc.calc, capacity)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &CachedCalculator{
+		calc: cached,
+	}, nil
+}
+
+// ComputeRate returns the memoized result for the rate, or computes it with Calculator.ComputeRate.
+// Errors aren't memoized.
+func (c *CachedCalculator) ComputeRate(rate shopspring.Decimal) (shopspring.Decimal, error) {
+	result, err := c.calc.ComputeRate(decimal{d: rate})
+	if err != nil {
+		return shopspring.Decimal{}, translateError(err)
+	}
+
+	return result.d, nil
+}
+
+// Stats returns a snapshot of the CachedCalculator counters.
+func (c *CachedCalculator) Stats() tsratecalc.CacheStats {
+	return c.calc.Stats()
+}
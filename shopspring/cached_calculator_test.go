@@ -0,0 +1,163 @@
+package shopspring_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+func TestCachedCalculator_ComputeRate(t *testing.T) {
+	t.Parallel()
+
+	calc, err := shopspring.NewCalculator(shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.New(9, -1),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	cached, err := shopspring.NewCachedCalculator(calc, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	steps := []struct {
+		rate      string
+		wantStats tsratecalc.CacheStats
+	}{
+		{rate: "0.1", wantStats: tsratecalc.CacheStats{Misses: 1, Len: 1}},
+		// Same canonical String() representation.
+		{rate: "0.10", wantStats: tsratecalc.CacheStats{Hits: 1, Misses: 1, Len: 1}},
+		{rate: "0.2", wantStats: tsratecalc.CacheStats{Hits: 1, Misses: 2, Len: 2}},
+		// "0.1" is the most recently used now, so "0.2" will be evicted.
+		{rate: "0.1", wantStats: tsratecalc.CacheStats{Hits: 2, Misses: 2, Len: 2}},
+		{rate: "0.3", wantStats: tsratecalc.CacheStats{Hits: 2, Misses: 3, Evictions: 1, Len: 2}},
+		{rate: "0.1", wantStats: tsratecalc.CacheStats{Hits: 3, Misses: 3, Evictions: 1, Len: 2}},
+		{rate: "0.2", wantStats: tsratecalc.CacheStats{Hits: 3, Misses: 4, Evictions: 2, Len: 2}},
+	}
+
+	for _, step := range steps {
+		rate := decimal.RequireFromString(step.rate)
+
+		got, err := cached.ComputeRate(rate)
+		if err != nil {
+			t.Fatalf("unexpected error for rate '%s': %s", step.rate, err.Error())
+		}
+
+		want, err := calc.ComputeRate(rate)
+		if err != nil {
+			t.Fatalf("unexpected error for rate '%s': %s", step.rate, err.Error())
+		}
+
+		if !got.Equal(want) {
+			t.Fatalf("unexpected result for rate '%s': got '%s', want '%s'", step.rate, got, want)
+		}
+
+		if stats := cached.Stats(); stats != step.wantStats {
+			t.Fatalf("unexpected stats after rate '%s': got %+v, want %+v", step.rate, stats, step.wantStats)
+		}
+	}
+}
+
+func TestCachedCalculator_ComputeRate_Error(t *testing.T) {
+	t.Parallel()
+
+	calc, err := shopspring.NewCalculator(shopspring.Config{
+		Root:              252,
+		Precision:         10,
+		ConvergenceRadius: decimal.New(5, -1),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	cached, err := shopspring.NewCachedCalculator(calc, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	rate := decimal.New(6, -1)
+
+	_, wantErr := calc.ComputeRate(rate)
+
+	for range 2 {
+		_, err := cached.ComputeRate(rate)
+		if !errors.Is(err, tsratecalc.ErrRateOutsideConvergenceBoundaries) || err.Error() != wantErr.Error() {
+			t.Fatalf("unexpected error: got %v, want %v", err, wantErr)
+		}
+	}
+
+	if stats := cached.Stats(); stats.Len != 0 || stats.Misses != 2 {
+		t.Fatalf("errors should not be cached: got %+v", stats)
+	}
+
+	_, err = shopspring.NewCachedCalculator(calc, 0)
+	if !errors.Is(err, tsratecalc.ErrCachedCalculatorCapacityPositive) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCachedCalculator_ComputeRate_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	calc, err := shopspring.NewCalculator(shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.New(9, -1),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	cached, err := shopspring.NewCachedCalculator(calc, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	rates := []string{"0.01", "-0.01", "0.1", "-0.1", "0.1375", "-0.5"}
+
+	var wg sync.WaitGroup
+
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range 10 {
+				for _, rate := range rates {
+					got, err := cached.ComputeRate(decimal.RequireFromString(rate))
+					if err != nil {
+						t.Errorf("unexpected error for rate '%s': %s", rate, err.Error())
+
+						return
+					}
+
+					want, err := calc.ComputeRate(decimal.RequireFromString(rate))
+					if err != nil {
+						t.Errorf("unexpected error for rate '%s': %s", rate, err.Error())
+
+						return
+					}
+
+					if !got.Equal(want) {
+						t.Errorf("unexpected result for rate '%s': got '%s', want '%s'", rate, got, want)
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if stats := cached.Stats(); stats.Len > 4 || stats.Hits+stats.Misses != 8*10*uint64(len(rates)) {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
@@ -1,7 +1,9 @@
 package shopspring
 
 import (
+	"context"
 	"errors"
+	"log/slog"
 
 	shopspring "github.com/shopspring/decimal"
 
@@ -12,6 +14,8 @@ var (
 	ErrConfigPrecisionNegative = errors.New("result precision must be positive")
 	ErrRootNegative            = errors.New("root must be positive")
 	ErrMaxTermsCacheNegative   = errors.New("max terms cache must be positive")
+	ErrGuardDigitsNegative     = errors.New("guard digits must be positive")
+	ErrMarginPercentNegative   = errors.New("auto radius margin percent must be positive")
 )
 
 type Config struct {
@@ -21,18 +25,63 @@ type Config struct {
 	// Precision is the number of decimal places to consider in the calculations.
 	// The calculation will only stop when the error is lower than 10^(-precision)/2.
 	Precision int32
+	// PrecisionMode defines how Precision is interpreted: tsratecalc.DecimalPlaces (default) or tsratecalc.SignificantDigits.
+	PrecisionMode tsratecalc.PrecisionMode
 	// ConvergenceRadius sets the desired convergence radius for the rate value,
 	// and will dynamically define how many Taylor Series terms will be used and pre-computed.
 	//
 	// The calculator will expand Taylor Series around x=0, until the convergence radius
 	// boundaries (i.e. 0 + radius and 0 - radius) have error lower than the provided precision.
 	//
-	// It's recommended to be lower than 1. If it's greater than 1, the number of iterations (and Taylor terms cache)
-	// required to converge on boundaries will grow exponentially.
+	// It should be lower than 1, since the number of terms required to converge on the boundaries has no bound otherwise:
+	// a radius greater than or equal to 1 is rejected with tsratecalc.TermsLimitError.
+	//
+	// It's ignored if AutoRadius is provided.
 	ConvergenceRadius shopspring.Decimal
+	// AutoRadius derives the ConvergenceRadius from the expected rates domain, with a safety margin.
+	// If not provided, ConvergenceRadius will be used.
+	AutoRadius *AutoRadius
 	// MaxTermsCache is the maximum number of Taylor terms that will be cached.
 	// If not provided, DefaultMaxTermsCache will be used.
+	// Configs whose predicted number of terms (see EstimateTerms) clearly doesn't fit are rejected with tsratecalc.TermsLimitError.
 	MaxTermsCache int32
+	// LazyTermsCache enables the lazy growth of the Taylor terms cache.
+	// The cache will only grow as far as required by the largest absolute rate value computed so far.
+	LazyTermsCache bool
+	// Verify enables the self-verification of every computed rate.
+	// After computing "y = (1+x)^(1/n)-1", the calculator will check if "(1+y)^n" is close enough to "1+x",
+	// returning VerificationError otherwise.
+	Verify bool
+	// GuardDigits is the number of extra decimal places used by the intermediate calculations, on top of Precision.
+	// If not provided, a single guard digit is used, unless AutoGuardDigits is set.
+	// See tsratecalc.Config.GuardDigits for details.
+	GuardDigits int32
+	// AutoGuardDigits derives GuardDigits from MaxTermsCache when it's not provided.
+	// See tsratecalc.Config.AutoGuardDigits for details.
+	AutoGuardDigits bool
+	// Evaluation is the scheme used to evaluate the Taylor polynomial, once the number of terms is known.
+	// If not provided, tsratecalc.EvaluationHorner will be used.
+	Evaluation tsratecalc.Evaluation
+	// Observer receives the Calculator events, e.g. to export metrics. If not provided, no event is reported.
+	Observer tsratecalc.Observer
+	// Logger receives the Calculator lifecycle and failure logs. If not provided, nothing is logged.
+	// See tsratecalc.Config.Logger for details.
+	Logger *slog.Logger
+	// LogComputations enables a Debug log for every computation. It requires Logger.
+	LogComputations bool
+}
+
+// AutoRadius is a wrapper around tsratecalc.AutoRadius for "github.com/shopspring/decimal".Decimal type.
+type AutoRadius struct {
+	// Rates are the expected rates: the domain boundaries (e.g. the min and max rates), or a sample of historical rates.
+	// The convergence radius is the largest |rate|, plus the safety margin.
+	Rates []shopspring.Decimal
+	// MarginPercent is the safety margin added to the largest |rate|, in percent.
+	// If not provided, tsratecalc.DefaultAutoRadiusMarginPercent will be used.
+	MarginPercent int32
+	// Expand enables the expansion of the convergence boundaries when a rate outside them is computed,
+	// instead of returning tsratecalc.ErrRateOutsideConvergenceBoundaries. See tsratecalc.AutoRadius.Expand for details.
+	Expand bool
 }
 
 // Calculator is a wrapper around tsratecalc.Calculator for "github.com/shopspring/decimal".Decimal type.
@@ -42,35 +91,86 @@ type Calculator struct {
 
 // NewCalculator creates a new calculator with the given Config.
 func NewCalculator(cfg Config) (*Calculator, error) {
+	return NewCalculatorContext(context.Background(), cfg)
+}
+
+// NewCalculatorContext is the same as NewCalculator, but the Taylor terms cache construction could be canceled by the context,
+// and customized by the provided options (e.g. WithProgress).
+//
+// If the context is done before the construction ends, BuildCanceledError is returned with partial diagnostics.
+func NewCalculatorContext(ctx context.Context, cfg Config, opts ...Option) (*Calculator, error) {
+	underlyingCfg, err := underlyingConfig(cfg)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	calc, err := tsratecalc.NewCalculatorContext[decimal](ctx, underlyingCfg, underlyingOptions(opts)...)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &Calculator{
+		calc: calc,
+	}, nil
+}
+
+// underlyingConfig validates the Config and converts it to the tsratecalc.Config.
+func underlyingConfig(cfg Config) (tsratecalc.Config[decimal], error) {
 	if cfg.Precision < 0 {
-		return nil, ErrConfigPrecisionNegative
+		return tsratecalc.Config[decimal]{}, ErrConfigPrecisionNegative
 	}
 
 	if cfg.Root < 0 {
-		return nil, ErrRootNegative
+		return tsratecalc.Config[decimal]{}, ErrRootNegative
 	}
 
 	if cfg.MaxTermsCache < 0 {
-		return nil, ErrMaxTermsCacheNegative
+		return tsratecalc.Config[decimal]{}, ErrMaxTermsCacheNegative
 	}
 
-	underlyingCfg := tsratecalc.Config[decimal]{
-		Root:       uint64(cfg.Root),
-		Precision:  uint64(cfg.Precision),
-		NewFromInt: newFromIntFunc,
-		ConvergenceRadius: decimal{
-			cfg.ConvergenceRadius,
-		},
-		MaxTermsCache: uint64(cfg.MaxTermsCache),
+	if cfg.GuardDigits < 0 {
+		return tsratecalc.Config[decimal]{}, ErrGuardDigitsNegative
 	}
 
-	calc, err := tsratecalc.NewCalculator[decimal](underlyingCfg)
-	if err != nil {
-		return nil, err
+	var autoRadius *tsratecalc.AutoRadius[decimal]
+
+	if cfg.AutoRadius != nil {
+		if cfg.AutoRadius.MarginPercent < 0 {
+			return tsratecalc.Config[decimal]{}, ErrMarginPercentNegative
+		}
+
+		rates := make([]decimal, 0, len(cfg.AutoRadius.Rates))
+
+		for _, rate := range cfg.AutoRadius.Rates {
+			rates = append(rates, decimal{rate})
+		}
+
+		autoRadius = &tsratecalc.AutoRadius[decimal]{
+			Rates:         rates,
+			MarginPercent: uint64(cfg.AutoRadius.MarginPercent),
+			Expand:        cfg.AutoRadius.Expand,
+		}
 	}
 
-	return &Calculator{
-		calc: calc,
+	return tsratecalc.Config[decimal]{
+		Root:          uint64(cfg.Root),
+		Precision:     uint64(cfg.Precision),
+		PrecisionMode: cfg.PrecisionMode,
+		NewFromInt:    newFromIntFunc,
+		NewFromString: newFromStringFunc,
+		ConvergenceRadius: decimal{
+			cfg.ConvergenceRadius,
+		},
+		AutoRadius:      autoRadius,
+		MaxTermsCache:   uint64(cfg.MaxTermsCache),
+		LazyTermsCache:  cfg.LazyTermsCache,
+		Verify:          cfg.Verify,
+		GuardDigits:     uint64(cfg.GuardDigits),
+		AutoGuardDigits: cfg.AutoGuardDigits,
+		Evaluation:      cfg.Evaluation,
+		Observer:        cfg.Observer,
+		Logger:          cfg.Logger,
+		LogComputations: cfg.LogComputations,
 	}, nil
 }
 
@@ -78,7 +178,7 @@ func NewCalculator(cfg Config) (*Calculator, error) {
 // The root is defined in the calculator Config.
 //
 // The rate value should fall within the Config.ConvergenceRadius interval, around rate=0,
-// otherwise ErrRateOutsideConvergenceBoundaries will be returned.
+// otherwise BoundaryError (wrapping tsratecalc.ErrRateOutsideConvergenceBoundaries) will be returned.
 //
 // It will return ConvergenceError if the desired precision is not achieved after the maximum number of iterations.
 func (c *Calculator) ComputeRate(rate shopspring.Decimal) (shopspring.Decimal, error) {
@@ -86,13 +186,51 @@ func (c *Calculator) ComputeRate(rate shopspring.Decimal) (shopspring.Decimal, e
 
 	result, err := c.calc.ComputeRate(d)
 	if err != nil {
-		return shopspring.Decimal{}, err
+		return shopspring.Decimal{}, translateError(err)
 	}
 
 	return result.d, nil
 }
 
+// ComputeRateWithPrecision is the same as ComputeRate, but the result will have the provided number of decimal places,
+// instead of the Config.Precision.
+//
+// The precision should be lower than or equal to the Config.Precision, otherwise tsratecalc.ErrPrecisionAboveConfig is returned.
+func (c *Calculator) ComputeRateWithPrecision(rate shopspring.Decimal, precision int32) (shopspring.Decimal, error) {
+	if precision < 0 {
+		return shopspring.Decimal{}, ErrConfigPrecisionNegative
+	}
+
+	result, err := c.calc.ComputeRateWithPrecision(decimal{d: rate}, uint64(precision))
+	if err != nil {
+		return shopspring.Decimal{}, translateError(err)
+	}
+
+	return result.d, nil
+}
+
+// ConvergenceRadius returns the current convergence radius.
+// See tsratecalc.Calculator.ConvergenceRadius for details.
+func (c *Calculator) ConvergenceRadius() shopspring.Decimal {
+	return c.calc.ConvergenceRadius().d
+}
+
 // TermsCacheLen returns the number of Taylor terms stored in the calculator's cache.
 func (c *Calculator) TermsCacheLen() int {
 	return c.calc.TermsCacheLen()
 }
+
+// Verify computes the rate exactly like ComputeRate, then cross-checks the result against the exact reference
+// computed by the "github.com/mqzabin/tsratecalc/oracle" package.
+//
+// It will return ReferenceMismatchError if the result is not closer than 10^(-precision) from the exact value.
+func (c *Calculator) Verify(rate shopspring.Decimal) (shopspring.Decimal, error) {
+	d := decimal{d: rate}
+
+	result, err := c.calc.Verify(d)
+	if err != nil {
+		return shopspring.Decimal{}, translateError(err)
+	}
+
+	return result.d, nil
+}
@@ -1,12 +1,19 @@
 package shopspring_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/mqzabin/fuzzdecimal"
 	"github.com/shopspring/decimal"
 
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/oracle"
 	"github.com/mqzabin/tsratecalc/shopspring"
+	"github.com/mqzabin/tsratecalc/tsratecalcfuzz"
 )
 
 func BenchmarkCalculator_ComputeRate_30Digits(b *testing.B) {
@@ -117,6 +124,41 @@ func BenchmarkCalculator_ComputeRate_10Digits(b *testing.B) {
 	})
 }
 
+func BenchmarkCalculator_ComputeRate_Evaluation(b *testing.B) {
+	rate := decimal.New(1, -1) // 10%
+
+	for _, precision := range []int32{30, 10} {
+		for _, evaluation := range []tsratecalc.Evaluation{tsratecalc.EvaluationPowers, tsratecalc.EvaluationHorner, tsratecalc.EvaluationEstrin} {
+			b.Run(fmt.Sprintf("%dDigits/%s", precision, evaluation), func(b *testing.B) {
+				cfg := shopspring.Config{
+					Root:              252,
+					Precision:         precision,
+					ConvergenceRadius: decimal.New(9, -1),
+					Evaluation:        evaluation,
+				}
+
+				calc, err := shopspring.NewCalculator(cfg)
+				if err != nil {
+					b.Fatalf("NewCalculator: %v", err)
+				}
+
+				var avoidOptimizations decimal.Decimal
+
+				b.ReportAllocs()
+				b.ResetTimer()
+
+				for i := 0; i < b.N; i++ {
+					avoidOptimizations, _ = calc.ComputeRate(rate)
+				}
+
+				if avoidOptimizations.IsZero() {
+					b.Fatalf("unexpected zero result")
+				}
+			})
+		}
+	}
+}
+
 func TestNewCalculator(t *testing.T) {
 	t.Parallel()
 
@@ -176,9 +218,8 @@ func TestNewCalculator(t *testing.T) {
 
 func FuzzComputeRateShopspring(f *testing.F) {
 	const (
-		resultPrecision   = 30
-		divisionPrecision = 30
-		root              = 252
+		resultPrecision = 30
+		root            = 252
 	)
 
 	cfg := shopspring.Config{
@@ -192,34 +233,545 @@ func FuzzComputeRateShopspring(f *testing.F) {
 		f.Fatalf("NewCalculator: %v", err)
 	}
 
-	parseDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
-		t.Helper()
+	tsratecalcfuzz.FuzzCalculator(f, tsratecalcfuzz.FuzzConfig[decimal.Decimal]{
+		Root:        root,
+		Precision:   resultPrecision,
+		Parse:       decimal.NewFromString,
+		ComputeRate: calc.ComputeRate,
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithMaxSignificantDigits(resultPrecision),
+		fuzzdecimal.WithMaxDecimalPlaces(resultPrecision),
+		fuzzdecimal.WithUnsigned(),
+	))
+}
+
+func TestCalculator_Verify(t *testing.T) {
+	t.Parallel()
 
-		return decimal.NewFromString(s)
+	calc, err := shopspring.NewCalculator(shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.New(9, -1),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
 	}
 
-	one := decimal.NewFromInt(1)
-	refExponent := one.DivRound(decimal.NewFromInt(root), divisionPrecision)
+	for _, rate := range []string{"0", "0.1", "-0.1", "0.1375", "0.85", "-0.5"} {
+		t.Run(rate, func(t *testing.T) {
+			t.Parallel()
 
-	fuzzdecimal.Fuzz(f, 1, func(t *fuzzdecimal.T) {
-		fuzzdecimal.AsDecimalComparison1(t, "ComputeRate", parseDecimal, parseDecimal,
-			func(t *fuzzdecimal.T, x1 decimal.Decimal) (string, error) {
-				t.Helper()
+			_, err := calc.Verify(decimal.RequireFromString(rate))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		})
+	}
+}
 
-				return x1.Add(one).Pow(refExponent).Sub(one).Truncate(resultPrecision).StringFixed(resultPrecision), nil
-			},
-			func(t *fuzzdecimal.T, x1 decimal.Decimal) string {
-				res, err := calc.ComputeRate(x1)
+func TestCalculator_ComputeRate_SelfVerification(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		precision int32
+		rate      string
+	}{
+		{name: "positive rate with 30 digits", precision: 30, rate: "0.1375"},
+		{name: "negative rate with 30 digits", precision: 30, rate: "-0.8"},
+		{name: "rate near boundary with 30 digits", precision: 30, rate: "0.85"},
+		{name: "positive rate with 10 digits", precision: 10, rate: "0.1375"},
+		{name: "zero rate with 10 digits", precision: 10, rate: "0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			calc, err := shopspring.NewCalculator(shopspring.Config{
+				Root:              252,
+				Precision:         tc.precision,
+				ConvergenceRadius: decimal.New(9, -1),
+				Verify:            true,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			_, err = calc.ComputeRate(decimal.RequireFromString(tc.rate))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestCalculator_ComputeRateWithPrecision(t *testing.T) {
+	t.Parallel()
+
+	calc, err := shopspring.NewCalculator(shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.New(9, -1),
+		Verify:            true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	rates := []string{"0", "0.1", "-0.1", "0.1375", "0.85", "-0.5"}
+
+	for _, precision := range []int32{0, 8, 10, 16, 30} {
+		for _, rate := range rates {
+			t.Run(fmt.Sprintf("%s with %d digits", rate, precision), func(t *testing.T) {
+				t.Parallel()
+
+				got, err := calc.ComputeRateWithPrecision(decimal.RequireFromString(rate), precision)
 				if err != nil {
-					t.Fatalf("ComputeRate: %v", err)
+					t.Fatalf("unexpected error: %s", err.Error())
 				}
 
-				return res.StringFixed(resultPrecision)
-			},
+				want, err := oracle.RateString(rate, 252, uint64(precision))
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				if got.StringFixed(precision) != want {
+					t.Fatalf("unexpected result: got '%s', want '%s'", got.StringFixed(precision), want)
+				}
+			})
+		}
+	}
+
+	_, err = calc.ComputeRateWithPrecision(decimal.RequireFromString("0.1"), 31)
+	if !errors.Is(err, tsratecalc.ErrPrecisionAboveConfig) {
+		t.Fatalf("unexpected error: got %v, want %v", err, tsratecalc.ErrPrecisionAboveConfig)
+	}
+}
+
+func TestCalculator_ComputeRate_LazyTermsCache(t *testing.T) {
+	t.Parallel()
+
+	cfg := shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.New(9, -1),
+	}
+
+	eager, err := shopspring.NewCalculator(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	cfg.LazyTermsCache = true
+
+	lazy, err := shopspring.NewCalculator(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if lazy.TermsCacheLen() != 0 {
+		t.Fatalf("unexpected terms cache length: got %d, want 0", lazy.TermsCacheLen())
+	}
+
+	rates := []string{"0.01", "-0.01", "0.1", "-0.1", "0.1375", "-0.5", "0.85"}
+
+	var wg sync.WaitGroup
+
+	for _, rate := range rates {
+		for range 4 {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				got, err := lazy.ComputeRate(decimal.RequireFromString(rate))
+				if err != nil {
+					t.Errorf("unexpected error for rate '%s': %s", rate, err.Error())
+
+					return
+				}
+
+				want, err := eager.ComputeRate(decimal.RequireFromString(rate))
+				if err != nil {
+					t.Errorf("unexpected error for rate '%s': %s", rate, err.Error())
+
+					return
+				}
+
+				if !got.Equal(want) {
+					t.Errorf("unexpected result for rate '%s': got '%s', want '%s'", rate, got, want)
+				}
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	if lazy.TermsCacheLen() >= eager.TermsCacheLen() {
+		t.Fatalf("lazy terms cache should be smaller than the eager one: got %d, eager has %d", lazy.TermsCacheLen(), eager.TermsCacheLen())
+	}
+}
+
+func TestNewCalculatorContext(t *testing.T) {
+	t.Parallel()
+
+	cfg := shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.RequireFromString("0.9"),
+	}
+
+	t.Run("progress", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			calls    int
+			lastTerm uint64
 		)
-	}, fuzzdecimal.WithAllDecimals(
-		fuzzdecimal.WithMaxSignificantDigits(resultPrecision),
-		fuzzdecimal.WithMaxDecimalPlaces(resultPrecision),
-		fuzzdecimal.WithUnsigned(),
-	))
+
+		calc, err := shopspring.NewCalculatorContext(context.Background(), cfg, shopspring.WithProgress(func(p shopspring.Progress) {
+			calls++
+
+			if p.Term != lastTerm+1 {
+				t.Errorf("expected term %d, got %d", lastTerm+1, p.Term)
+			}
+
+			lastTerm = p.Term
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if calls != calc.TermsCacheLen() {
+			t.Errorf("expected %d progress calls, got %d", calc.TermsCacheLen(), calls)
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		t.Parallel()
+
+		const cancelAfterTerms = 10
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		_, err := shopspring.NewCalculatorContext(ctx, cfg, shopspring.WithProgress(func(p shopspring.Progress) {
+			if p.Term == cancelAfterTerms {
+				cancel()
+			}
+		}))
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got: %v", err)
+		}
+
+		var canceledErr *shopspring.BuildCanceledError
+		if !errors.As(err, &canceledErr) {
+			t.Fatalf("expected BuildCanceledError, got: %T", err)
+		}
+
+		if canceledErr.Terms != cancelAfterTerms {
+			t.Errorf("expected %d computed terms, got %d", cancelAfterTerms, canceledErr.Terms)
+		}
+
+		if canceledErr.LowerBoundaryError.IsZero() || canceledErr.UpperBoundaryError.IsZero() {
+			t.Errorf("expected non-zero boundary errors, got '%s' and '%s'", canceledErr.LowerBoundaryError, canceledErr.UpperBoundaryError)
+		}
+	})
+}
+
+func TestCalculator_ComputeRate_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	const goroutines = 16
+
+	calc, err := shopspring.NewCalculator(shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.New(9, -1),
+		Verify:            true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	rates := []string{"0", "0.01", "-0.01", "0.1", "-0.1", "0.1375", "0.85", "-0.5"}
+	precisions := []int32{30, 16, 8}
+
+	want := make(map[string]decimal.Decimal)
+
+	for _, rate := range rates {
+		for _, precision := range precisions {
+			res, err := calc.ComputeRateWithPrecision(decimal.RequireFromString(rate), precision)
+			if err != nil {
+				t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+			}
+
+			want[fmt.Sprintf("%s/%d", rate, precision)] = res
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	for g := range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range rates {
+				// Each goroutine walks the rates in a different order.
+				rate := rates[(i+g)%len(rates)]
+				precision := precisions[(i+g)%len(precisions)]
+
+				got, err := calc.ComputeRateWithPrecision(decimal.RequireFromString(rate), precision)
+				if err != nil {
+					t.Errorf("unexpected error for rate '%s': %s", rate, err.Error())
+
+					return
+				}
+
+				if key := fmt.Sprintf("%s/%d", rate, precision); !got.Equal(want[key]) {
+					t.Errorf("unexpected result for rate '%s' and precision %d: got '%s', want '%s'", rate, precision, got, want[key])
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestCalculator_ComputeRate_Thresholds(t *testing.T) {
+	t.Parallel()
+
+	var rates []decimal.Decimal
+
+	for exp := int32(1); exp <= 12; exp++ {
+		for _, mantissa := range []int64{1, 3, 5, 7, 9, 11, 13, 17} {
+			rates = append(rates, decimal.New(mantissa, -exp), decimal.New(-mantissa, -exp))
+		}
+	}
+
+	rates = append(rates, decimal.RequireFromString("0.85"))
+
+	for _, precision := range []int32{30, 10} {
+		cfg := shopspring.Config{
+			Root:              252,
+			Precision:         precision,
+			ConvergenceRadius: decimal.New(9, -1),
+		}
+
+		// Eager caches evaluate a fixed number of terms for each |rate| bucket.
+		calc, err := shopspring.NewCalculator(cfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		for _, rate := range rates {
+			if rate.Abs().GreaterThanOrEqual(cfg.ConvergenceRadius) {
+				continue
+			}
+
+			got, err := calc.ComputeRate(rate)
+			if err != nil {
+				t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+			}
+
+			want, err := oracle.RateString(rate.String(), uint64(cfg.Root), uint64(precision))
+			if err != nil {
+				t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+			}
+
+			if got.String() != decimal.RequireFromString(want).String() {
+				t.Errorf("unexpected result for rate '%s' and precision %d: got '%s', want '%s'", rate, precision, got, want)
+			}
+		}
+	}
+}
+
+func TestCalculator_ComputeRate_Evaluation(t *testing.T) {
+	t.Parallel()
+
+	rates := []string{"0", "0.0001", "-0.0001", "0.01", "-0.01", "0.1", "-0.1", "0.1375", "0.5", "-0.5", "0.85"}
+
+	cfg := shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.New(9, -1),
+	}
+
+	horner, err := shopspring.NewCalculator(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	for _, evaluation := range []tsratecalc.Evaluation{tsratecalc.EvaluationPowers, tsratecalc.EvaluationEstrin} {
+		cfg.Evaluation = evaluation
+
+		calc, err := shopspring.NewCalculator(cfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		// shopspring decimals have exact multiplication and addition, so every scheme gives the same result.
+		for _, rate := range rates {
+			got, err := calc.ComputeRate(decimal.RequireFromString(rate))
+			if err != nil {
+				t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+			}
+
+			want, err := horner.ComputeRate(decimal.RequireFromString(rate))
+			if err != nil {
+				t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+			}
+
+			if !got.Equal(want) {
+				t.Errorf("unexpected %s result for rate '%s': got '%s', want '%s'", evaluation, rate, got, want)
+			}
+		}
+	}
+
+	cfg.Evaluation = tsratecalc.Evaluation(-1)
+
+	_, err = shopspring.NewCalculator(cfg)
+	if !errors.Is(err, tsratecalc.ErrConfigEvaluationInvalid) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCalculator_ComputeRate_GuardDigits(t *testing.T) {
+	t.Parallel()
+
+	// Near the boundaries, hundreds of terms are summed and their rounding errors accumulate.
+	rates := []string{"-0.89", "-0.87", "-0.85", "-0.7", "-0.6", "0.6", "0.7", "0.85", "0.89"}
+
+	for _, precision := range []int32{30, 10} {
+		cfg := shopspring.Config{
+			Root:              252,
+			Precision:         precision,
+			ConvergenceRadius: decimal.New(9, -1),
+		}
+
+		// The default is a single guard digit.
+		legacy, err := shopspring.NewCalculator(cfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		cfg.AutoGuardDigits = true
+
+		calc, err := shopspring.NewCalculator(cfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		var legacyMismatches int
+
+		for _, rate := range rates {
+			want, err := oracle.RateString(rate, uint64(cfg.Root), uint64(precision))
+			if err != nil {
+				t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+			}
+
+			got, err := calc.ComputeRate(decimal.RequireFromString(rate))
+			if err != nil {
+				t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+			}
+
+			if !got.Equal(decimal.RequireFromString(want)) {
+				t.Errorf("unexpected result for rate '%s' and precision %d: got '%s', want '%s'", rate, precision, got, want)
+			}
+
+			gotLegacy, err := legacy.ComputeRate(decimal.RequireFromString(rate))
+			if err != nil || !gotLegacy.Equal(decimal.RequireFromString(want)) {
+				legacyMismatches++
+			}
+		}
+
+		if legacyMismatches == 0 {
+			t.Errorf("expected a single guard digit to be inaccurate near the boundaries for precision %d", precision)
+		}
+	}
+
+	_, err := shopspring.NewCalculator(shopspring.Config{Root: 252, Precision: 30, ConvergenceRadius: decimal.New(9, -1), GuardDigits: -1})
+	if !errors.Is(err, shopspring.ErrGuardDigitsNegative) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCalculator_ComputeRate_SignificantDigits(t *testing.T) {
+	t.Parallel()
+
+	const root = 252
+
+	testCases := []struct {
+		rate string
+		// wantPlaces is the number of decimal places that keeps 10 significant digits of "rate/root".
+		wantPlaces uint64
+	}{
+		{rate: "0.1", wantPlaces: 13},
+		{rate: "-0.1", wantPlaces: 13},
+		{rate: "0.85", wantPlaces: 12},
+		{rate: "0.000001", wantPlaces: 18},
+		{rate: "0.000000000001", wantPlaces: 24},
+		{rate: "-0.000000000001", wantPlaces: 24},
+		{rate: "0.0000000000000000000000123", wantPlaces: 35},
+	}
+
+	for _, lazy := range []bool{false, true} {
+		// The results are compared with the exact truncated values, so it requires more than a single guard digit.
+		calc, err := shopspring.NewCalculator(shopspring.Config{
+			Root:              root,
+			Precision:         10,
+			PrecisionMode:     tsratecalc.SignificantDigits,
+			ConvergenceRadius: decimal.New(9, -1),
+			LazyTermsCache:    lazy,
+			Verify:            true,
+			AutoGuardDigits:   true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		for _, tc := range testCases {
+			got, err := calc.ComputeRate(decimal.RequireFromString(tc.rate))
+			if err != nil {
+				t.Fatalf("unexpected error for rate '%s': %s", tc.rate, err.Error())
+			}
+
+			want, err := oracle.RateString(tc.rate, root, tc.wantPlaces)
+			if err != nil {
+				t.Fatalf("unexpected error for rate '%s': %s", tc.rate, err.Error())
+			}
+
+			if !got.Equal(decimal.RequireFromString(want)) {
+				t.Errorf("unexpected result for rate '%s' (lazy=%t): got '%s', want '%s'", tc.rate, lazy, got, want)
+			}
+
+			_, err = calc.Verify(decimal.RequireFromString(tc.rate))
+			if err != nil {
+				t.Errorf("unexpected verification error for rate '%s': %s", tc.rate, err.Error())
+			}
+		}
+
+		// The precision of a single call is also interpreted as significant digits.
+		got, err := calc.ComputeRateWithPrecision(decimal.RequireFromString("0.000000000001"), 4)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if want := decimal.RequireFromString("0.000000000000003968"); !got.Equal(want) {
+			t.Errorf("unexpected result with 4 significant digits: got '%s', want '%s'", got, want)
+		}
+	}
+
+	_, err := shopspring.NewCalculator(shopspring.Config{
+		Root:              root,
+		Precision:         10,
+		PrecisionMode:     tsratecalc.PrecisionMode(-1),
+		ConvergenceRadius: decimal.New(9, -1),
+	})
+	if !errors.Is(err, tsratecalc.ErrConfigPrecisionModeInvalid) {
+		t.Fatalf("unexpected error: %v", err)
+	}
 }
@@ -0,0 +1,72 @@
+package shopspring
+
+import (
+	"errors"
+
+	shopspring "github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+)
+
+var ErrConverterLengthNegative = errors.New("converter period lengths must be positive")
+
+// Converter is a wrapper around tsratecalc.Converter for "github.com/shopspring/decimal".Decimal type.
+type Converter struct {
+	conv *tsratecalc.Converter[decimal]
+}
+
+// NewConverter creates a new Converter from periods of fromLength to periods of toLength, in the same unit (e.g. days).
+// The Config.Root is ignored, since it's defined by the lengths ratio. See tsratecalc.NewConverter for details.
+func NewConverter(cfg Config, fromLength, toLength int32) (*Converter, error) {
+	if fromLength < 0 || toLength < 0 {
+		return nil, ErrConverterLengthNegative
+	}
+
+	underlyingCfg, err := underlyingConfig(cfg)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	conv, err := tsratecalc.NewConverter[decimal](underlyingCfg, uint64(fromLength), uint64(toLength))
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &Converter{
+		conv: conv,
+	}, nil
+}
+
+// NewPeriodConverter creates a new Converter from rates of the Period from to rates of the Period to.
+// See tsratecalc.NewPeriodConverter for details.
+func NewPeriodConverter(cfg Config, from, to tsratecalc.Period) (*Converter, error) {
+	underlyingCfg, err := underlyingConfig(cfg)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	conv, err := tsratecalc.NewPeriodConverter[decimal](underlyingCfg, from, to)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &Converter{
+		conv: conv,
+	}, nil
+}
+
+// Exponent returns the reduced exponent "p/q" used by the conversions.
+func (c *Converter) Exponent() (p, q uint64) {
+	return c.conv.Exponent()
+}
+
+// Convert receives a rate of the source period and returns the equivalent rate of the target period, "(1+rate)^(p/q) - 1".
+// See tsratecalc.Converter.Convert for details.
+func (c *Converter) Convert(rate shopspring.Decimal) (shopspring.Decimal, error) {
+	result, err := c.conv.Convert(decimal{d: rate})
+	if err != nil {
+		return shopspring.Decimal{}, translateError(err)
+	}
+
+	return result.d, nil
+}
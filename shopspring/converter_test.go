@@ -0,0 +1,150 @@
+package shopspring_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/oracle"
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+func TestConverter_Convert(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		fromLength int32
+		toLength   int32
+		mode       tsratecalc.PrecisionMode
+		wantP      uint64
+		wantQ      uint64
+	}{
+		{name: "monthly to business daily", fromLength: 21, toLength: 1, wantP: 1, wantQ: 21},
+		{name: "monthly to daily", fromLength: 30, toLength: 1, wantP: 1, wantQ: 30},
+		{name: "quarterly to monthly", fromLength: 3, toLength: 1, wantP: 1, wantQ: 3},
+		{name: "business daily to monthly", fromLength: 1, toLength: 21, wantP: 21, wantQ: 1},
+		{name: "semiannual to annual", fromLength: 6, toLength: 12, wantP: 2, wantQ: 1},
+		{name: "same length", fromLength: 4, toLength: 4, wantP: 1, wantQ: 1},
+		{name: "monthly to 45 days", fromLength: 30, toLength: 45, wantP: 3, wantQ: 2},
+		{name: "calendar year to business year", fromLength: 365, toLength: 252, wantP: 252, wantQ: 365},
+		{name: "significant digits", fromLength: 30, toLength: 45, mode: tsratecalc.SignificantDigits, wantP: 3, wantQ: 2},
+	}
+
+	rates := []string{"0", "0.0001", "-0.0001", "0.1375", "-0.3", "0.5"}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			conv, err := shopspring.NewConverter(shopspring.Config{
+				Precision:         30,
+				PrecisionMode:     tc.mode,
+				ConvergenceRadius: decimal.RequireFromString("0.9"),
+			}, tc.fromLength, tc.toLength)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if p, q := conv.Exponent(); p != tc.wantP || q != tc.wantQ {
+				t.Fatalf("expected exponent %d/%d, got %d/%d", tc.wantP, tc.wantQ, p, q)
+			}
+
+			for _, rate := range rates {
+				got, err := conv.Convert(decimal.RequireFromString(rate))
+				if err != nil {
+					t.Fatalf("unexpected error for rate %s: %s", rate, err.Error())
+				}
+
+				// (1+rate)^(p/q)-1 is the root q of "(1+rate)^p - 1".
+				onePlusRate, _ := new(big.Rat).SetString("1")
+				onePlusRate.Add(onePlusRate, mustParseRat(t, rate))
+
+				powered := new(big.Rat).SetInt64(1)
+				for range tc.wantP {
+					powered.Mul(powered, onePlusRate)
+				}
+
+				powered.Sub(powered, big.NewRat(1, 1))
+
+				places := uint64(30)
+				if tc.mode == tsratecalc.SignificantDigits {
+					places = significantPlaces(got, 30)
+				}
+
+				ok, err := oracle.WithinULP(powered, mustParseRat(t, got.String()), tc.wantQ, places)
+				if err != nil {
+					t.Fatalf("unexpected error for rate %s: %s", rate, err.Error())
+				}
+
+				if !ok {
+					reference, _ := oracle.Rate(powered, tc.wantQ, places)
+
+					t.Fatalf("rate %s: expected %s, got %s", rate, reference.FloatString(int(places)), got.String())
+				}
+			}
+		})
+	}
+}
+
+func TestNewPeriodConverter(t *testing.T) {
+	t.Parallel()
+
+	cfg := shopspring.Config{
+		Precision:         30,
+		ConvergenceRadius: decimal.RequireFromString("0.9"),
+	}
+
+	conv, err := shopspring.NewPeriodConverter(cfg, tsratecalc.PeriodMonthly, tsratecalc.PeriodBusinessDaily252)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if p, q := conv.Exponent(); p != 1 || q != 21 {
+		t.Fatalf("expected exponent 1/21, got %d/%d", p, q)
+	}
+
+	_, err = shopspring.NewPeriodConverter(cfg, tsratecalc.PeriodDaily365, tsratecalc.PeriodBusinessDaily252)
+	if !errors.Is(err, tsratecalc.ErrPeriodDayCountMismatch) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = shopspring.NewConverter(cfg, 0, 1)
+	if !errors.Is(err, tsratecalc.ErrConverterLengthInvalid) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = shopspring.NewConverter(cfg, -1, 1)
+	if !errors.Is(err, shopspring.ErrConverterLengthNegative) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func mustParseRat(t *testing.T, s string) *big.Rat {
+	t.Helper()
+
+	r, err := oracle.ParseRat(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	return r
+}
+
+// significantPlaces returns the number of decimal places of a result with the provided number of significant digits.
+func significantPlaces(d decimal.Decimal, digits uint64) uint64 {
+	if d.IsZero() {
+		return digits + 64
+	}
+
+	// magnitude is the number of integer digits, or minus the number of leading zeros after the decimal point.
+	magnitude := int64(d.NumDigits()) + int64(d.Exponent())
+	if magnitude > 0 {
+		return digits - 1
+	}
+
+	return digits + uint64(-magnitude)
+}
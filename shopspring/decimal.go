@@ -33,6 +33,17 @@ func newFromIntFunc(n uint64) (decimal, error) {
 	}, nil
 }
 
+func newFromStringFunc(s string) (decimal, error) {
+	d, err := shopspring.NewFromString(s)
+	if err != nil {
+		return decimal{}, err
+	}
+
+	return decimal{
+		d: d,
+	}, nil
+}
+
 func (d decimal) Mul(n decimal) (decimal, error) {
 	return decimal{
 		d: d.d.Mul(n.d),
@@ -0,0 +1,24 @@
+package shopspring
+
+import (
+	"testing"
+
+	shopspring "github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc/tsratecalctest"
+)
+
+func TestDecimal_OperatorConformance(t *testing.T) {
+	t.Parallel()
+
+	parse := func(s string) (decimal, error) {
+		d, err := shopspring.NewFromString(s)
+		if err != nil {
+			return decimal{}, err
+		}
+
+		return decimal{d: d}, nil
+	}
+
+	tsratecalctest.RunOperatorConformance(t, newFromIntFunc, parse)
+}
@@ -0,0 +1,255 @@
+package shopspring
+
+import (
+	"errors"
+	"fmt"
+
+	shopspring "github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+)
+
+// ConvergenceError is the "github.com/shopspring/decimal".Decimal equivalent of tsratecalc.ConvergenceError.
+// It wraps tsratecalc.ErrRateNotConverged.
+type ConvergenceError struct {
+	// Precision is the desired number of decimal places to consider in the calculations.
+	Precision uint64
+	// Rate is the rate value that could not converge.
+	Rate shopspring.Decimal
+	// Iterations is the number of iterations that were performed.
+	Iterations int
+	// LastError is the last approximation error.
+	LastError shopspring.Decimal
+	// PartialResult is the partial result of the calculation.
+	PartialResult shopspring.Decimal
+}
+
+func (e *ConvergenceError) Error() string {
+	return fmt.Sprintf(
+		"rate '%s' could not converge to %d digits of precision, it converged to '%s' with %d iterations, last approximation error was '%s'",
+		e.Rate.String(),
+		e.Precision,
+		e.PartialResult.String(),
+		e.Iterations,
+		e.LastError.String(),
+	)
+}
+
+func (e *ConvergenceError) Unwrap() error {
+	return tsratecalc.ErrRateNotConverged
+}
+
+// ReferenceMismatchError is the "github.com/shopspring/decimal".Decimal equivalent of tsratecalc.ReferenceMismatchError.
+type ReferenceMismatchError struct {
+	// Root is the root used in the calculations.
+	Root uint64
+	// Precision is the desired number of decimal places to consider in the calculations.
+	Precision uint64
+	// Rate is the rate value that was computed.
+	Rate shopspring.Decimal
+	// Result is the computed result.
+	Result shopspring.Decimal
+	// Reference is the exact result, truncated to the desired precision.
+	Reference string
+}
+
+func (e *ReferenceMismatchError) Error() string {
+	return fmt.Sprintf(
+		"rate '%s' computed with root %d resulted in '%s', but the reference with %d digits of precision is '%s'",
+		e.Rate.String(),
+		e.Root,
+		e.Result.String(),
+		e.Precision,
+		e.Reference,
+	)
+}
+
+// VerificationError is the "github.com/shopspring/decimal".Decimal equivalent of tsratecalc.VerificationError.
+type VerificationError struct {
+	// Root is the root used in the calculations.
+	Root uint64
+	// Precision is the desired number of decimal places to consider in the calculations.
+	Precision uint64
+	// Rate is the rate value that was computed.
+	Rate shopspring.Decimal
+	// Result is the computed result.
+	Result shopspring.Decimal
+	// Expected is "1+rate".
+	Expected shopspring.Decimal
+	// Power is "(1+result)^root", which should be close to Expected.
+	Power shopspring.Decimal
+	// Tolerance is the maximum accepted difference between Power and Expected.
+	Tolerance shopspring.Decimal
+}
+
+func (e *VerificationError) Error() string {
+	return fmt.Sprintf(
+		"rate '%s' resulted in '%s', but (1+result)^%d is '%s', which differs from '%s' by more than '%s'",
+		e.Rate.String(),
+		e.Result.String(),
+		e.Root,
+		e.Power.String(),
+		e.Expected.String(),
+		e.Tolerance.String(),
+	)
+}
+
+// DivergenceError is the "github.com/shopspring/decimal".Decimal equivalent of tsratecalc.DivergenceError.
+// It wraps tsratecalc.ErrConvergenceBoundaryDiverging.
+type DivergenceError struct {
+	// Boundary is the convergence boundary (i.e. "-radius" or "radius") where the series is diverging.
+	Boundary shopspring.Decimal
+	// Term is the index of the Taylor term whose error is greater than the previous one, starting at 1.
+	Term uint64
+	// TermError is the error (absolute value of the term) on the boundary.
+	TermError shopspring.Decimal
+	// LastTermError is the error of the previous term on the boundary.
+	LastTermError shopspring.Decimal
+}
+
+func (e *DivergenceError) Error() string {
+	return fmt.Sprintf(
+		"%s: boundary '%s' error grew from '%s' to '%s' on taylor term %d",
+		tsratecalc.ErrConvergenceBoundaryDiverging.Error(),
+		e.Boundary.String(),
+		e.LastTermError.String(),
+		e.TermError.String(),
+		e.Term,
+	)
+}
+
+func (e *DivergenceError) Unwrap() error {
+	return tsratecalc.ErrConvergenceBoundaryDiverging
+}
+
+// BoundaryError is the "github.com/shopspring/decimal".Decimal equivalent of tsratecalc.BoundaryError.
+// It wraps tsratecalc.ErrRateOutsideConvergenceBoundaries.
+type BoundaryError struct {
+	// Lower is the lower convergence boundary. Rates lower than or equal to it are outside the boundaries.
+	Lower shopspring.Decimal
+	// Upper is the upper convergence boundary. Rates greater than it are outside the boundaries.
+	Upper shopspring.Decimal
+	// Rate is the rate value outside the boundaries.
+	Rate shopspring.Decimal
+}
+
+func (e *BoundaryError) Error() string {
+	return fmt.Sprintf(
+		"%s: boundaries are ('%s', '%s'] and rate to compute is '%s'",
+		tsratecalc.ErrRateOutsideConvergenceBoundaries.Error(),
+		e.Lower.String(),
+		e.Upper.String(),
+		e.Rate.String(),
+	)
+}
+
+func (e *BoundaryError) Unwrap() error {
+	return tsratecalc.ErrRateOutsideConvergenceBoundaries
+}
+
+// translatedError keeps the original error message and chain, adding the translated errors to it.
+type translatedError struct {
+	err error
+	// chain is the translated errors, followed by the original error.
+	chain []error
+}
+
+func (e *translatedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *translatedError) Unwrap() []error {
+	return e.chain
+}
+
+// translateError adds the "github.com/shopspring/decimal".Decimal equivalents of the tsratecalc generic errors
+// to the error chain, so their fields could be read outside this package with errors.As.
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var translated []error
+
+	var convergenceErr *tsratecalc.ConvergenceError[decimal]
+	if errors.As(err, &convergenceErr) {
+		translated = append(translated, &ConvergenceError{
+			Precision:     convergenceErr.Precision,
+			Rate:          convergenceErr.Rate.d,
+			Iterations:    convergenceErr.Iterations,
+			LastError:     convergenceErr.LastError.d,
+			PartialResult: convergenceErr.PartialResult.d,
+		})
+	}
+
+	var mismatchErr *tsratecalc.ReferenceMismatchError[decimal]
+	if errors.As(err, &mismatchErr) {
+		translated = append(translated, &ReferenceMismatchError{
+			Root:      mismatchErr.Root,
+			Precision: mismatchErr.Precision,
+			Rate:      mismatchErr.Rate.d,
+			Result:    mismatchErr.Result.d,
+			Reference: mismatchErr.Reference,
+		})
+	}
+
+	var verificationErr *tsratecalc.VerificationError[decimal]
+	if errors.As(err, &verificationErr) {
+		translated = append(translated, &VerificationError{
+			Root:      verificationErr.Root,
+			Precision: verificationErr.Precision,
+			Rate:      verificationErr.Rate.d,
+			Result:    verificationErr.Result.d,
+			Expected:  verificationErr.Expected.d,
+			Power:     verificationErr.Power.d,
+			Tolerance: verificationErr.Tolerance.d,
+		})
+	}
+
+	var divergenceErr *tsratecalc.DivergenceError[decimal]
+	if errors.As(err, &divergenceErr) {
+		translated = append(translated, &DivergenceError{
+			Boundary:      divergenceErr.Boundary.d,
+			Term:          divergenceErr.Term,
+			TermError:     divergenceErr.TermError.d,
+			LastTermError: divergenceErr.LastTermError.d,
+		})
+	}
+
+	var boundaryErr *tsratecalc.BoundaryError[decimal]
+	if errors.As(err, &boundaryErr) {
+		translated = append(translated, &BoundaryError{
+			Lower: boundaryErr.Lower.d,
+			Upper: boundaryErr.Upper.d,
+			Rate:  boundaryErr.Rate.d,
+		})
+	}
+
+	var canceledErr *tsratecalc.BuildCanceledError[decimal]
+	if errors.As(err, &canceledErr) {
+		translated = append(translated, &BuildCanceledError{
+			Err:                canceledErr.Err,
+			Terms:              canceledErr.Terms,
+			LowerBoundaryError: canceledErr.LowerBoundaryError.d,
+			UpperBoundaryError: canceledErr.UpperBoundaryError.d,
+		})
+	}
+
+	if len(translated) == 0 {
+		return err
+	}
+
+	return &translatedError{
+		err:   err,
+		chain: append(translated, err),
+	}
+}
+
+// translateErrors translates each error of the slice, keeping nil errors.
+func translateErrors(errs []error) []error {
+	for i, err := range errs {
+		errs[i] = translateError(err)
+	}
+
+	return errs
+}
@@ -0,0 +1,109 @@
+package shopspring
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	shopspring "github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+)
+
+func TestCalculator_ComputeRate_TypedErrors(t *testing.T) {
+	t.Parallel()
+
+	calc, err := NewCalculator(Config{
+		Root:              252,
+		Precision:         10,
+		ConvergenceRadius: shopspring.RequireFromString("0.5"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	_, err = calc.ComputeRate(shopspring.RequireFromString("0.6"))
+	if !errors.Is(err, tsratecalc.ErrRateOutsideConvergenceBoundaries) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var boundaryErr *BoundaryError
+	if !errors.As(err, &boundaryErr) {
+		t.Fatalf("unexpected error type: %T", err)
+	}
+
+	if !boundaryErr.Rate.Equal(shopspring.RequireFromString("0.6")) ||
+		!boundaryErr.Lower.Equal(shopspring.RequireFromString("-0.5")) ||
+		!boundaryErr.Upper.Equal(shopspring.RequireFromString("0.5")) {
+		t.Fatalf("unexpected error: %+v", boundaryErr)
+	}
+
+	// The last cached term is never used, so the boundary itself doesn't converge.
+	_, err = calc.ComputeRate(shopspring.RequireFromString("0.5"))
+
+	var convergenceErr *ConvergenceError
+	if !errors.As(err, &convergenceErr) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !convergenceErr.Rate.Equal(shopspring.RequireFromString("0.5")) || convergenceErr.Iterations != calc.TermsCacheLen() {
+		t.Fatalf("unexpected error: %+v", convergenceErr)
+	}
+}
+
+func TestTranslateError(t *testing.T) {
+	t.Parallel()
+
+	d := func(s string) decimal {
+		return decimal{d: shopspring.RequireFromString(s)}
+	}
+
+	divergenceErr := &tsratecalc.DivergenceError[decimal]{
+		Boundary:      d("1.5"),
+		Term:          3,
+		TermError:     d("0.2"),
+		LastTermError: d("0.1"),
+	}
+
+	operatorErr := &tsratecalc.OperatorError{
+		Op:   "Mul",
+		Step: "computing rate^n",
+		Term: 2,
+		Err:  errors.New("overflow"),
+	}
+
+	wrapped := fmt.Errorf("computing taylor terms cache: %w", divergenceErr)
+
+	err := translateError(wrapped)
+	if err.Error() != wrapped.Error() {
+		t.Fatalf("unexpected error message: got '%s', want '%s'", err.Error(), wrapped.Error())
+	}
+
+	if !errors.Is(err, tsratecalc.ErrConvergenceBoundaryDiverging) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var translatedErr *DivergenceError
+	if !errors.As(err, &translatedErr) {
+		t.Fatalf("unexpected error type: %T", err)
+	}
+
+	if !translatedErr.Boundary.Equal(shopspring.RequireFromString("1.5")) || translatedErr.Term != 3 {
+		t.Fatalf("unexpected error: %+v", translatedErr)
+	}
+
+	// The original error is kept in the chain.
+	var underlyingErr *tsratecalc.DivergenceError[decimal]
+	if !errors.As(err, &underlyingErr) || underlyingErr != divergenceErr {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Errors without Decimal fields are returned unchanged.
+	if err := translateError(operatorErr); err != error(operatorErr) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if translateError(nil) != nil {
+		t.Fatalf("expected nil error")
+	}
+}
@@ -0,0 +1,27 @@
+package shopspring
+
+import (
+	"github.com/mqzabin/tsratecalc"
+)
+
+// EstimateTerms predicts how many Taylor terms NewCalculator would compute for the Config, without computing them.
+// See tsratecalc.EstimateTerms for details.
+func EstimateTerms(cfg Config) (uint64, error) {
+	underlyingCfg, err := underlyingConfig(cfg)
+	if err != nil {
+		return 0, err
+	}
+
+	return tsratecalc.EstimateTerms(underlyingCfg)
+}
+
+// EstimateCost predicts the number of terms, memory and operations of the Config Taylor terms cache, without computing it.
+// See tsratecalc.EstimateCost for details.
+func EstimateCost(cfg Config) (tsratecalc.Cost, error) {
+	underlyingCfg, err := underlyingConfig(cfg)
+	if err != nil {
+		return tsratecalc.Cost{}, err
+	}
+
+	return tsratecalc.EstimateCost(underlyingCfg)
+}
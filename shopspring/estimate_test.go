@@ -0,0 +1,159 @@
+package shopspring_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+func TestEstimateTerms(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		cfg  shopspring.Config
+	}{
+		{
+			name: "root 252, precision 30, radius 0.9",
+			cfg:  shopspring.Config{Root: 252, Precision: 30, ConvergenceRadius: decimal.RequireFromString("0.9")},
+		},
+		{
+			name: "root 252, precision 30, radius 0.8",
+			cfg:  shopspring.Config{Root: 252, Precision: 30, ConvergenceRadius: decimal.RequireFromString("0.8")},
+		},
+		{
+			name: "root 12, precision 10, radius 0.9",
+			cfg:  shopspring.Config{Root: 12, Precision: 10, ConvergenceRadius: decimal.RequireFromString("0.9")},
+		},
+		{
+			name: "root 2, precision 5, radius 0.1",
+			cfg:  shopspring.Config{Root: 2, Precision: 5, ConvergenceRadius: decimal.RequireFromString("0.1")},
+		},
+		{
+			name: "root 252, precision 30, radius 0.9, auto guard digits",
+			cfg:  shopspring.Config{Root: 252, Precision: 30, ConvergenceRadius: decimal.RequireFromString("0.9"), AutoGuardDigits: true},
+		},
+		{
+			name: "root 12, precision 10, radius 0.3, significant digits",
+			cfg: shopspring.Config{
+				Root:              12,
+				Precision:         10,
+				ConvergenceRadius: decimal.RequireFromString("0.3"),
+				PrecisionMode:     tsratecalc.SignificantDigits,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := shopspring.EstimateTerms(tc.cfg)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			calc, err := shopspring.NewCalculator(tc.cfg)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if want := uint64(calc.TermsCacheLen()); got != want {
+				t.Fatalf("unexpected estimate: got %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	t.Parallel()
+
+	cost, err := shopspring.EstimateCost(shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.RequireFromString("0.9"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// 30 decimal places plus a single guard digit, by default.
+	if cost.Terms != 550 || cost.WorkingPrecision != 31 {
+		t.Fatalf("unexpected cost: %+v", cost)
+	}
+
+	if cost.Bytes == 0 || cost.Operations == 0 {
+		t.Fatalf("unexpected cost: %+v", cost)
+	}
+
+	// The Taylor series diverges outside the (-1, 1) interval.
+	cost, err = shopspring.EstimateCost(shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.New(1, 0),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if cost.Terms != math.MaxUint64 || cost.Bytes != math.MaxUint64 || cost.Operations != math.MaxUint64 {
+		t.Fatalf("unexpected cost: %+v", cost)
+	}
+
+	_, err = shopspring.EstimateCost(shopspring.Config{Root: -1})
+	if !errors.Is(err, shopspring.ErrRootNegative) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNewCalculator_TermsLimit(t *testing.T) {
+	t.Parallel()
+
+	cfg := shopspring.Config{
+		Root:              252,
+		Precision:         10,
+		ConvergenceRadius: decimal.RequireFromString("0.9"),
+		MaxTermsCache:     100,
+	}
+
+	for _, lazy := range []bool{false, true} {
+		cfg.LazyTermsCache = lazy
+
+		_, err := shopspring.NewCalculator(cfg)
+		if !errors.Is(err, tsratecalc.ErrConfigTermsExceedMaxTermsCache) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var limitErr *tsratecalc.TermsLimitError
+		if !errors.As(err, &limitErr) {
+			t.Fatalf("unexpected error type: %T", err)
+		}
+
+		if limitErr.PredictedTerms <= 100 || limitErr.MaxTermsCache != 100 {
+			t.Fatalf("unexpected error: %+v", limitErr)
+		}
+
+		// The largest radius that fits should build.
+		maxRadius := decimal.RequireFromString(limitErr.MaxConvergenceRadius)
+
+		cfg.ConvergenceRadius = maxRadius
+
+		if _, err := shopspring.NewCalculator(cfg); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		// The next one doesn't fit, but it's too close to the limit to be rejected by the estimate.
+		cfg.ConvergenceRadius = maxRadius.Add(decimal.New(1, -6))
+
+		if _, err := shopspring.NewCalculator(cfg); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		cfg.ConvergenceRadius = decimal.RequireFromString("0.9")
+	}
+}
@@ -0,0 +1,158 @@
+package shopspring_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+// decodeLogs decodes the JSON log records written to buf.
+func decodeLogs(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+
+	dec := json.NewDecoder(buf)
+
+	for dec.More() {
+		var record map[string]any
+
+		if err := dec.Decode(&record); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func TestCalculator_Logger(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		logComputations bool
+		level           slog.Level
+		// wantMessages are the expected log messages, after the build and a successful and a non-converging computation.
+		wantMessages []string
+	}{
+		{
+			name:         "lifecycle",
+			level:        slog.LevelDebug,
+			wantMessages: []string{"calculator built", "rate could not converge"},
+		},
+		{
+			name:            "computations",
+			logComputations: true,
+			level:           slog.LevelDebug,
+			wantMessages:    []string{"calculator built", "rate computed", "rate could not converge"},
+		},
+		{
+			name:            "computations without debug level",
+			logComputations: true,
+			level:           slog.LevelInfo,
+			wantMessages:    []string{"calculator built", "rate could not converge"},
+		},
+		{
+			name:         "warnings only",
+			level:        slog.LevelWarn,
+			wantMessages: []string{"rate could not converge"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			calc, err := shopspring.NewCalculator(shopspring.Config{
+				Root:              12,
+				Precision:         10,
+				ConvergenceRadius: decimal.RequireFromString("0.5"),
+				Logger:            slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: tc.level})),
+				LogComputations:   tc.logComputations,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if _, err := calc.ComputeRate(decimal.RequireFromString("0.1")); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			// The last cached term is never used, so the boundary itself doesn't converge.
+			if _, err := calc.ComputeRate(decimal.RequireFromString("0.5")); err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+
+			records := decodeLogs(t, &buf)
+
+			if len(records) != len(tc.wantMessages) {
+				t.Fatalf("expected %d logs, got %d: %v", len(tc.wantMessages), len(records), records)
+			}
+
+			for i, record := range records {
+				if record["msg"] != tc.wantMessages[i] {
+					t.Fatalf("expected log %d message '%s', got '%v'", i, tc.wantMessages[i], record["msg"])
+				}
+
+				if record["root"] != float64(12) || record["precision"] != float64(10) || record["radius"] != "0.5" {
+					t.Fatalf("unexpected log %d attributes: %v", i, record)
+				}
+
+				switch record["msg"] {
+				case "calculator built":
+					if record["level"] != "INFO" || record["terms"] != float64(calc.TermsCacheLen()) {
+						t.Fatalf("unexpected build log: %v", record)
+					}
+				case "rate computed":
+					if record["level"] != "DEBUG" || record["rate"] != "0.1" || record["result"] == nil || record["terms"] == nil {
+						t.Fatalf("unexpected computation log: %v", record)
+					}
+				case "rate could not converge":
+					if record["level"] != "WARN" || record["rate"] != "0.5" ||
+						record["term"] != float64(calc.TermsCacheLen()) || record["last_error"] == nil || record["error"] == nil {
+						t.Fatalf("unexpected convergence log: %v", record)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNewCalculator_LoggerRejectedConfig(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	_, err := shopspring.NewCalculator(shopspring.Config{
+		Root:              12,
+		Precision:         10,
+		ConvergenceRadius: decimal.RequireFromString("0.99"),
+		MaxTermsCache:     100,
+		Logger:            slog.New(slog.NewJSONHandler(&buf, nil)),
+	})
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	records := decodeLogs(t, &buf)
+
+	if len(records) != 1 {
+		t.Fatalf("expected 1 log, got %d: %v", len(records), records)
+	}
+
+	record := records[0]
+
+	if record["msg"] != "calculator config rejected" || record["level"] != "ERROR" ||
+		record["radius"] != "0.99" || record["max_radius"] == nil || record["max_terms_cache"] != float64(100) {
+		t.Fatalf("unexpected log: %v", record)
+	}
+}
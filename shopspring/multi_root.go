@@ -0,0 +1,80 @@
+package shopspring
+
+import (
+	shopspring "github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+)
+
+// MultiRootCalculator is a wrapper around tsratecalc.MultiRootCalculator for "github.com/shopspring/decimal".Decimal type.
+type MultiRootCalculator struct {
+	calc *tsratecalc.MultiRootCalculator[decimal]
+}
+
+// NewMultiRootCalculator creates a new multi root calculator with the given Config.
+// The Config.Root is ignored, since the root is provided on each ComputeRate call.
+func NewMultiRootCalculator(cfg Config) (*MultiRootCalculator, error) {
+	underlyingCfg, err := underlyingConfig(cfg)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	calc, err := tsratecalc.NewMultiRootCalculator[decimal](underlyingCfg)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &MultiRootCalculator{
+		calc: calc,
+	}, nil
+}
+
+// ComputeRate receives a rate value and returns "(1+rate)^(1/root) - 1" using a Taylor Series expansion around rate=0.
+//
+// The first call for each root will build its Taylor terms cache, so it's slower than the subsequent ones.
+func (m *MultiRootCalculator) ComputeRate(root int32, rate shopspring.Decimal) (shopspring.Decimal, error) {
+	if root < 0 {
+		return shopspring.Decimal{}, ErrRootNegative
+	}
+
+	result, err := m.calc.ComputeRate(uint64(root), decimal{d: rate})
+	if err != nil {
+		return shopspring.Decimal{}, translateError(err)
+	}
+
+	return result.d, nil
+}
+
+// ComputeRateWithPrecision is the same as ComputeRate, but the result will have the provided number of decimal places,
+// which should be lower than or equal to the Config.Precision.
+func (m *MultiRootCalculator) ComputeRateWithPrecision(root int32, rate shopspring.Decimal, precision int32) (shopspring.Decimal, error) {
+	if root < 0 {
+		return shopspring.Decimal{}, ErrRootNegative
+	}
+
+	if precision < 0 {
+		return shopspring.Decimal{}, ErrConfigPrecisionNegative
+	}
+
+	result, err := m.calc.ComputeRateWithPrecision(uint64(root), decimal{d: rate}, uint64(precision))
+	if err != nil {
+		return shopspring.Decimal{}, translateError(err)
+	}
+
+	return result.d, nil
+}
+
+// TermsCacheLen returns the number of Taylor terms stored in the cache of the provided root,
+// building it if it wasn't requested before.
+func (m *MultiRootCalculator) TermsCacheLen(root int32) (int, error) {
+	if root < 0 {
+		return 0, ErrRootNegative
+	}
+
+	calc, err := m.calc.Calculator(uint64(root))
+	if err != nil {
+		return 0, translateError(err)
+	}
+
+	return calc.TermsCacheLen(), nil
+}
@@ -0,0 +1,84 @@
+package shopspring_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+func TestMultiRootCalculator_ComputeRate(t *testing.T) {
+	t.Parallel()
+
+	cfg := shopspring.Config{
+		Precision:         30,
+		ConvergenceRadius: decimal.New(9, -1),
+	}
+
+	multi, err := shopspring.NewMultiRootCalculator(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	rate := decimal.RequireFromString("0.1375")
+
+	var wg sync.WaitGroup
+
+	for _, root := range []int32{252, 365, 360, 12, 2} {
+		for range 4 {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				got, err := multi.ComputeRate(root, rate)
+				if err != nil {
+					t.Errorf("unexpected error for root %d: %s", root, err.Error())
+
+					return
+				}
+
+				singleCfg := cfg
+				singleCfg.Root = root
+
+				single, err := shopspring.NewCalculator(singleCfg)
+				if err != nil {
+					t.Errorf("unexpected error for root %d: %s", root, err.Error())
+
+					return
+				}
+
+				want, err := single.ComputeRate(rate)
+				if err != nil {
+					t.Errorf("unexpected error for root %d: %s", root, err.Error())
+
+					return
+				}
+
+				if !got.Equal(want) {
+					t.Errorf("unexpected result for root %d: got '%s', want '%s'", root, got, want)
+				}
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	termsLen, err := multi.TermsCacheLen(252)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if termsLen != 550 {
+		t.Fatalf("unexpected terms cache length: got %d, want %d", termsLen, 550)
+	}
+
+	_, err = multi.ComputeRate(1, rate)
+	if !errors.Is(err, tsratecalc.ErrConfigRootMinValue) {
+		t.Fatalf("unexpected error: got %v, want %v", err, tsratecalc.ErrConfigRootMinValue)
+	}
+}
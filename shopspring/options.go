@@ -0,0 +1,86 @@
+package shopspring
+
+import (
+	"fmt"
+
+	shopspring "github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+)
+
+// Option customizes the Calculator construction on NewCalculatorContext.
+type Option func(o *options)
+
+type options struct {
+	progress func(p Progress)
+}
+
+// Progress reports the Taylor terms cache construction progress.
+type Progress struct {
+	// Term is the index of the last computed Taylor term, starting at 1.
+	Term uint64
+	// LowerBoundaryError is the error on the lower convergence boundary with the computed terms.
+	LowerBoundaryError shopspring.Decimal
+	// UpperBoundaryError is the error on the upper convergence boundary with the computed terms.
+	UpperBoundaryError shopspring.Decimal
+}
+
+// WithProgress sets a function to be called after each Taylor term is computed by NewCalculatorContext.
+// It's called synchronously, so it should be fast.
+func WithProgress(fn func(p Progress)) Option {
+	return func(o *options) {
+		o.progress = fn
+	}
+}
+
+// underlyingOptions converts the options to the tsratecalc.Option type.
+func underlyingOptions(opts []Option) []tsratecalc.Option[decimal] {
+	var o options
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.progress == nil {
+		return nil
+	}
+
+	progress := o.progress
+
+	return []tsratecalc.Option[decimal]{
+		tsratecalc.WithProgress(func(p tsratecalc.Progress[decimal]) {
+			progress(Progress{
+				Term:               p.Term,
+				LowerBoundaryError: p.LowerBoundaryError.d,
+				UpperBoundaryError: p.UpperBoundaryError.d,
+			})
+		}),
+	}
+}
+
+// BuildCanceledError is returned by NewCalculatorContext when the context is done before the Taylor terms cache is fully built.
+// It wraps the context error, so errors.Is(err, context.DeadlineExceeded) could be used.
+type BuildCanceledError struct {
+	// Err is the context error (or its cause).
+	Err error
+	// Terms is the number of Taylor terms computed before the cancellation.
+	Terms uint64
+	// LowerBoundaryError is the error on the lower convergence boundary with the computed terms.
+	LowerBoundaryError shopspring.Decimal
+	// UpperBoundaryError is the error on the upper convergence boundary with the computed terms.
+	UpperBoundaryError shopspring.Decimal
+}
+
+func (e *BuildCanceledError) Error() string {
+	return fmt.Sprintf(
+		"taylor terms cache build canceled after %d terms, lower boundary error was '%s' and upper boundary error was '%s': %v",
+		e.Terms,
+		e.LowerBoundaryError.String(),
+		e.UpperBoundaryError.String(),
+		e.Err,
+	)
+}
+
+func (e *BuildCanceledError) Unwrap() error {
+	return e.Err
+}
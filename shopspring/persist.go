@@ -0,0 +1,81 @@
+package shopspring
+
+import (
+	"github.com/mqzabin/tsratecalc"
+)
+
+// LoadCalculator creates a new calculator with the given Config, using the Taylor terms cache
+// encoded by Calculator.MarshalBinary, instead of computing it.
+//
+// The Config should be equal to the Config used to build the encoded cache,
+// otherwise tsratecalc.ErrCacheFingerprintMismatch is returned.
+func LoadCalculator(cfg Config, data []byte) (*Calculator, error) {
+	underlyingCfg, err := underlyingConfig(cfg)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	calc, err := tsratecalc.LoadCalculator[decimal](underlyingCfg, data)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &Calculator{
+		calc: calc,
+	}, nil
+}
+
+// LoadCalculatorJSON is the same as LoadCalculator, but for the cache encoded by Calculator.MarshalJSON.
+func LoadCalculatorJSON(cfg Config, data []byte) (*Calculator, error) {
+	underlyingCfg, err := underlyingConfig(cfg)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	calc, err := tsratecalc.LoadCalculatorJSON[decimal](underlyingCfg, data)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &Calculator{
+		calc: calc,
+	}, nil
+}
+
+// MarshalBinary encodes the calculator's Taylor terms cache, so it could be loaded later with LoadCalculator.
+func (c *Calculator) MarshalBinary() ([]byte, error) {
+	return c.calc.MarshalBinary()
+}
+
+// UnmarshalBinary replaces the calculator's Taylor terms cache with the one encoded by MarshalBinary.
+func (c *Calculator) UnmarshalBinary(data []byte) error {
+	return translateError(c.calc.UnmarshalBinary(data))
+}
+
+// MarshalJSON is the JSON form of MarshalBinary.
+func (c *Calculator) MarshalJSON() ([]byte, error) {
+	return c.calc.MarshalJSON()
+}
+
+// UnmarshalJSON is the JSON form of UnmarshalBinary.
+func (c *Calculator) UnmarshalJSON(data []byte) error {
+	return translateError(c.calc.UnmarshalJSON(data))
+}
+
+// NewCalculatorFromTerms creates a new calculator with the given Config, using the provided Taylor terms
+// instead of computing them. It's used by the code generated with cmd/tsratecalc-gen.
+func NewCalculatorFromTerms(cfg Config, terms []string) (*Calculator, error) {
+	underlyingCfg, err := underlyingConfig(cfg)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	calc, err := tsratecalc.NewCalculatorFromTerms[decimal](underlyingCfg, terms)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &Calculator{
+		calc: calc,
+	}, nil
+}
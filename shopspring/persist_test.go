@@ -0,0 +1,272 @@
+package shopspring_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+func TestLoadCalculator(t *testing.T) {
+	t.Parallel()
+
+	cfg := shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: decimal.New(9, -1),
+	}
+
+	calc, err := shopspring.NewCalculator(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	binaryData, err := calc.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	jsonData, err := calc.MarshalJSON()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	lazyCfg := cfg
+	lazyCfg.LazyTermsCache = true
+
+	testCases := []struct {
+		name string
+		load func() (*shopspring.Calculator, error)
+	}{
+		{
+			name: "binary",
+			load: func() (*shopspring.Calculator, error) { return shopspring.LoadCalculator(cfg, binaryData) },
+		},
+		{
+			name: "json",
+			load: func() (*shopspring.Calculator, error) { return shopspring.LoadCalculatorJSON(cfg, jsonData) },
+		},
+		{
+			name: "binary into lazy calculator",
+			load: func() (*shopspring.Calculator, error) {
+				lazy, err := shopspring.NewCalculator(lazyCfg)
+				if err != nil {
+					return nil, err
+				}
+
+				return lazy, lazy.UnmarshalBinary(binaryData)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			loaded, err := tc.load()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if loaded.TermsCacheLen() != calc.TermsCacheLen() {
+				t.Fatalf("unexpected terms cache length: got %d, want %d", loaded.TermsCacheLen(), calc.TermsCacheLen())
+			}
+
+			for _, rate := range []string{"0", "0.1", "-0.1", "0.1375", "0.85", "-0.5"} {
+				got, err := loaded.ComputeRate(decimal.RequireFromString(rate))
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				want, err := calc.ComputeRate(decimal.RequireFromString(rate))
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				if !got.Equal(want) {
+					t.Fatalf("unexpected result for rate '%s': got '%s', want '%s'", rate, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadCalculator_Errors(t *testing.T) {
+	t.Parallel()
+
+	cfg := shopspring.Config{
+		Root:              252,
+		Precision:         10,
+		ConvergenceRadius: decimal.New(5, -1),
+	}
+
+	calc, err := shopspring.NewCalculator(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	data, err := calc.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	otherCfg := cfg
+	otherCfg.Precision = 11
+
+	corrupt := func(index int) []byte {
+		c := append([]byte(nil), data...)
+		c[index] ^= 0xff
+
+		return c
+	}
+
+	testCases := []struct {
+		name    string
+		cfg     shopspring.Config
+		data    []byte
+		wantErr error
+	}{
+		{name: "different config", cfg: otherCfg, data: data, wantErr: tsratecalc.ErrCacheFingerprintMismatch},
+		{name: "corrupted term", cfg: cfg, data: corrupt(len(data) - 6), wantErr: tsratecalc.ErrCacheChecksumMismatch},
+		{name: "unsupported version", cfg: cfg, data: corrupt(5), wantErr: tsratecalc.ErrCacheUnsupportedVersion},
+		{name: "invalid magic", cfg: cfg, data: corrupt(0), wantErr: tsratecalc.ErrCacheInvalidFormat},
+		{name: "truncated data", cfg: cfg, data: data[:3], wantErr: tsratecalc.ErrCacheInvalidFormat},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := shopspring.LoadCalculator(tc.cfg, tc.data)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("unexpected error: got %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestCalculator_UnmarshalBinary_ShorterLazyCache(t *testing.T) {
+	t.Parallel()
+
+	cfg := shopspring.Config{
+		Root:              12,
+		Precision:         10,
+		ConvergenceRadius: decimal.RequireFromString("0.9"),
+		LazyTermsCache:    true,
+	}
+
+	saved, err := shopspring.NewCalculator(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if _, err := saved.ComputeRate(decimal.RequireFromString("0.01")); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	data, err := saved.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	calc, err := shopspring.NewCalculator(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// The cache grows beyond the snapshot before being replaced by it.
+	if _, err := calc.ComputeRate(decimal.RequireFromString("0.8")); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if calc.TermsCacheLen() <= saved.TermsCacheLen() {
+		t.Fatalf("expected more than %d terms, got %d", saved.TermsCacheLen(), calc.TermsCacheLen())
+	}
+
+	if err := calc.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	reference, err := shopspring.NewCalculator(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	assertSameResults(t, reference, calc, "0.5", "-0.5", "0.8", "0.01")
+}
+
+func TestLoadCalculator_PartialLazyCache(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []tsratecalc.PrecisionMode{tsratecalc.DecimalPlaces, tsratecalc.SignificantDigits} {
+		cfg := shopspring.Config{
+			Root:              12,
+			Precision:         10,
+			PrecisionMode:     mode,
+			ConvergenceRadius: decimal.RequireFromString("0.9"),
+		}
+
+		eager, err := shopspring.NewCalculator(cfg)
+		if err != nil {
+			t.Fatalf("unexpected error for %s mode: %s", mode, err.Error())
+		}
+
+		lazyCfg := cfg
+		lazyCfg.LazyTermsCache = true
+
+		lazy, err := shopspring.NewCalculator(lazyCfg)
+		if err != nil {
+			t.Fatalf("unexpected error for %s mode: %s", mode, err.Error())
+		}
+
+		if _, err := lazy.ComputeRate(decimal.RequireFromString("0.01")); err != nil {
+			t.Fatalf("unexpected error for %s mode: %s", mode, err.Error())
+		}
+
+		data, err := lazy.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unexpected error for %s mode: %s", mode, err.Error())
+		}
+
+		// Eager calculators can't grow, so they reject the partial cache.
+		err = eager.UnmarshalBinary(data)
+		if !errors.Is(err, tsratecalc.ErrCacheNotConverged) {
+			t.Fatalf("unexpected error for %s mode: %v", mode, err)
+		}
+
+		// Loading an eager calculator completes the partial cache, like the eager construction would.
+		loaded, err := shopspring.LoadCalculator(cfg, data)
+		if err != nil {
+			t.Fatalf("unexpected error for %s mode: %s", mode, err.Error())
+		}
+
+		if loaded.TermsCacheLen() != eager.TermsCacheLen() {
+			t.Fatalf("unexpected terms cache length for %s mode: got %d, want %d", mode, loaded.TermsCacheLen(), eager.TermsCacheLen())
+		}
+
+		assertSameResults(t, eager, loaded, "0.5", "-0.5", "0.85", "0.01", "0.000001")
+	}
+}
+
+func assertSameResults(t *testing.T, want, got *shopspring.Calculator, rates ...string) {
+	t.Helper()
+
+	for _, rate := range rates {
+		wantRes, err := want.ComputeRate(decimal.RequireFromString(rate))
+		if err != nil {
+			t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+		}
+
+		gotRes, err := got.ComputeRate(decimal.RequireFromString(rate))
+		if err != nil {
+			t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+		}
+
+		if !gotRes.Equal(wantRes) {
+			t.Fatalf("unexpected result for rate '%s': got %s, want %s", rate, gotRes.String(), wantRes.String())
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package shopspring
+
+import (
+	shopspring "github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+)
+
+// Rates is a wrapper around tsratecalc.Rates for "github.com/shopspring/decimal".Decimal type.
+type Rates struct {
+	rates *tsratecalc.Rates[decimal]
+}
+
+// NewRates creates a new Rates with the given Config.
+// The Config.Root is ignored, since the root is defined by each conversion.
+func NewRates(cfg Config) (*Rates, error) {
+	underlyingCfg, err := underlyingConfig(cfg)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	rates, err := tsratecalc.NewRates[decimal](underlyingCfg)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &Rates{
+		rates: rates,
+	}, nil
+}
+
+// Rate returns a Rate with the provided value, period and compounding, converted by the Rates calculators.
+func (r *Rates) Rate(value shopspring.Decimal, period tsratecalc.Period, compounding tsratecalc.Compounding) Rate {
+	return Rate{
+		Value:       value,
+		Period:      period,
+		Compounding: compounding,
+		rates:       r,
+	}
+}
+
+// Rate is a wrapper around tsratecalc.Rate for "github.com/shopspring/decimal".Decimal type.
+type Rate struct {
+	// Value is the rate over one Period, e.g. 0.1375 for 13.75%.
+	Value shopspring.Decimal
+	// Period is the period the Value refers to.
+	Period tsratecalc.Period
+	// Compounding defines how the Value accrues over many periods.
+	Compounding tsratecalc.Compounding
+
+	// rates converts the rate. It's nil if the Rate wasn't created by Rates.
+	rates *Rates
+}
+
+func (r Rate) String() string {
+	return r.underlying().String()
+}
+
+// To converts the rate to the target Period, keeping its Compounding. See tsratecalc.Rate.To for details.
+func (r Rate) To(target tsratecalc.Period) (Rate, error) {
+	converted, err := r.underlying().To(target)
+	if err != nil {
+		return Rate{}, translateError(err)
+	}
+
+	return Rate{
+		Value:       converted.Value.d,
+		Period:      converted.Period,
+		Compounding: converted.Compounding,
+		rates:       r.rates,
+	}, nil
+}
+
+// underlying converts the rate to the tsratecalc.Rate type.
+func (r Rate) underlying() tsratecalc.Rate[decimal] {
+	if r.rates == nil {
+		return tsratecalc.Rate[decimal]{
+			Value:       decimal{d: r.Value},
+			Period:      r.Period,
+			Compounding: r.Compounding,
+		}
+	}
+
+	return r.rates.rates.Rate(decimal{d: r.Value}, r.Period, r.Compounding)
+}
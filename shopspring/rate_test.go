@@ -0,0 +1,287 @@
+package shopspring_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+func TestRate_To(t *testing.T) {
+	t.Parallel()
+
+	cfg := shopspring.Config{
+		Precision:         20,
+		ConvergenceRadius: decimal.RequireFromString("0.9"),
+	}
+
+	rates, err := shopspring.NewRates(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	multi, err := shopspring.NewMultiRootCalculator(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	rootOf := func(root int32, rate string) decimal.Decimal {
+		res, err := multi.ComputeRate(root, decimal.RequireFromString(rate))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		return res
+	}
+
+	testCases := []struct {
+		name        string
+		value       string
+		from        tsratecalc.Period
+		to          tsratecalc.Period
+		compounding tsratecalc.Compounding
+		want        decimal.Decimal
+	}{
+		{
+			name:  "annual to business daily",
+			value: "0.1375",
+			from:  tsratecalc.PeriodAnnual,
+			to:    tsratecalc.PeriodBusinessDaily252,
+			want:  rootOf(252, "0.1375"),
+		},
+		{
+			name:  "annual to monthly",
+			value: "-0.05",
+			from:  tsratecalc.PeriodAnnual,
+			to:    tsratecalc.PeriodMonthly,
+			want:  rootOf(12, "-0.05"),
+		},
+		{
+			name:  "semiannual to quarterly",
+			value: "0.06",
+			from:  tsratecalc.PeriodSemiannual,
+			to:    tsratecalc.PeriodQuarterly,
+			want:  rootOf(2, "0.06"),
+		},
+		{
+			name:  "monthly to daily 360",
+			value: "0.01",
+			from:  tsratecalc.PeriodMonthly,
+			to:    tsratecalc.PeriodDaily360,
+			want:  rootOf(30, "0.01"),
+		},
+		{
+			name:  "monthly to annual",
+			value: "0.01",
+			from:  tsratecalc.PeriodMonthly,
+			to:    tsratecalc.PeriodAnnual,
+			want:  decimal.RequireFromString("0.12682503013196972066"),
+		},
+		{
+			name:  "same period",
+			value: "0.1375",
+			from:  tsratecalc.PeriodAnnual,
+			to:    tsratecalc.PeriodAnnual,
+			want:  decimal.RequireFromString("0.1375"),
+		},
+		{
+			name:        "nominal annual to monthly",
+			value:       "0.12",
+			from:        tsratecalc.PeriodAnnual,
+			to:          tsratecalc.PeriodMonthly,
+			compounding: tsratecalc.CompoundingNominal,
+			want:        decimal.RequireFromString("0.01"),
+		},
+		{
+			name:        "nominal annual to daily 365",
+			value:       "0.1",
+			from:        tsratecalc.PeriodAnnual,
+			to:          tsratecalc.PeriodDaily365,
+			compounding: tsratecalc.CompoundingNominal,
+			want:        decimal.RequireFromString("0.00027397260273972602"),
+		},
+		{
+			name:        "continuous business daily to annual",
+			value:       "0.0005",
+			from:        tsratecalc.PeriodBusinessDaily252,
+			to:          tsratecalc.PeriodAnnual,
+			compounding: tsratecalc.CompoundingContinuous,
+			want:        decimal.RequireFromString("0.126"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rate := rates.Rate(decimal.RequireFromString(tc.value), tc.from, tc.compounding)
+
+			got, err := rate.To(tc.to)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !got.Value.Equal(tc.want) {
+				t.Fatalf("expected %s, got %s", tc.want.String(), got.Value.String())
+			}
+
+			if got.Period != tc.to || got.Compounding != tc.compounding {
+				t.Fatalf("unexpected converted rate: %s", got)
+			}
+		})
+	}
+}
+
+func TestRate_To_Errors(t *testing.T) {
+	t.Parallel()
+
+	rates, err := shopspring.NewRates(shopspring.Config{
+		Precision:         20,
+		ConvergenceRadius: decimal.RequireFromString("0.9"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	testCases := []struct {
+		name           string
+		rate           shopspring.Rate
+		to             tsratecalc.Period
+		wantErr        error
+		wantConversion bool
+	}{
+		{
+			name:           "day count mismatch",
+			rate:           rates.Rate(decimal.RequireFromString("0.0005"), tsratecalc.PeriodDaily365, tsratecalc.CompoundingEffective),
+			to:             tsratecalc.PeriodBusinessDaily252,
+			wantErr:        tsratecalc.ErrPeriodDayCountMismatch,
+			wantConversion: true,
+		},
+		{
+			name:           "ratio not integral",
+			rate:           rates.Rate(decimal.RequireFromString("0.01"), tsratecalc.PeriodMonthly, tsratecalc.CompoundingEffective),
+			to:             tsratecalc.PeriodDaily365,
+			wantErr:        tsratecalc.ErrPeriodRatioNotIntegral,
+			wantConversion: true,
+		},
+		{
+			name:           "invalid period",
+			rate:           rates.Rate(decimal.RequireFromString("0.01"), tsratecalc.Period{Name: "never"}, tsratecalc.CompoundingEffective),
+			to:             tsratecalc.PeriodAnnual,
+			wantErr:        tsratecalc.ErrPeriodInvalid,
+			wantConversion: true,
+		},
+		{
+			name:           "invalid compounding",
+			rate:           rates.Rate(decimal.RequireFromString("0.01"), tsratecalc.PeriodAnnual, tsratecalc.Compounding(7)),
+			to:             tsratecalc.PeriodMonthly,
+			wantErr:        tsratecalc.ErrCompoundingInvalid,
+			wantConversion: true,
+		},
+		{
+			name:    "rate without rates",
+			rate:    shopspring.Rate{Value: decimal.RequireFromString("0.1375"), Period: tsratecalc.PeriodAnnual},
+			to:      tsratecalc.PeriodMonthly,
+			wantErr: tsratecalc.ErrRateWithoutRates,
+		},
+		{
+			name:    "rate outside convergence boundaries",
+			rate:    rates.Rate(decimal.RequireFromString("1.5"), tsratecalc.PeriodAnnual, tsratecalc.CompoundingEffective),
+			to:      tsratecalc.PeriodMonthly,
+			wantErr: tsratecalc.ErrRateOutsideConvergenceBoundaries,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tc.rate.To(tc.to)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var conversionErr *tsratecalc.PeriodConversionError
+			if errors.As(err, &conversionErr) != tc.wantConversion {
+				t.Fatalf("unexpected error type: %T", err)
+			}
+
+			if tc.wantConversion && (conversionErr.From != tc.rate.Period || conversionErr.To != tc.to) {
+				t.Fatalf("unexpected error: %+v", conversionErr)
+			}
+		})
+	}
+}
+
+func TestRate_To_SignificantDigits(t *testing.T) {
+	t.Parallel()
+
+	cfg := shopspring.Config{
+		Precision:         10,
+		PrecisionMode:     tsratecalc.SignificantDigits,
+		ConvergenceRadius: decimal.RequireFromString("0.9"),
+	}
+
+	rates, err := shopspring.NewRates(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	testCases := []struct {
+		name        string
+		value       string
+		from        tsratecalc.Period
+		to          tsratecalc.Period
+		compounding tsratecalc.Compounding
+		want        decimal.Decimal
+	}{
+		{
+			name:  "effective monthly to annual",
+			value: "0.000000000001",
+			from:  tsratecalc.PeriodMonthly,
+			to:    tsratecalc.PeriodAnnual,
+			want:  decimal.RequireFromString("0.0000000000120000000000"),
+		},
+		{
+			name:  "effective annual to monthly",
+			value: "0.000000000001",
+			from:  tsratecalc.PeriodAnnual,
+			to:    tsratecalc.PeriodMonthly,
+			want:  decimal.RequireFromString("0.00000000000008333333333"),
+		},
+		{
+			name:        "nominal annual to monthly",
+			value:       "0.000000000001",
+			from:        tsratecalc.PeriodAnnual,
+			to:          tsratecalc.PeriodMonthly,
+			compounding: tsratecalc.CompoundingNominal,
+			want:        decimal.RequireFromString("0.00000000000008333333333"),
+		},
+		{
+			name:        "continuous business daily to annual",
+			value:       "0.0000000000012345678912345",
+			from:        tsratecalc.PeriodBusinessDaily252,
+			to:          tsratecalc.PeriodAnnual,
+			compounding: tsratecalc.CompoundingContinuous,
+			want:        decimal.RequireFromString("0.0000000003111111085"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := rates.Rate(decimal.RequireFromString(tc.value), tc.from, tc.compounding).To(tc.to)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if got.Value.IsZero() || !got.Value.Equal(tc.want) {
+				t.Fatalf("expected %s, got %s", tc.want.String(), got.Value.String())
+			}
+		})
+	}
+}
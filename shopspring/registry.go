@@ -0,0 +1,56 @@
+package shopspring
+
+import (
+	"github.com/mqzabin/tsratecalc"
+)
+
+// Registry is a wrapper around tsratecalc.Registry for "github.com/shopspring/decimal".Decimal type.
+// Identical Configs share the same underlying calculator, built exactly once under concurrent demand.
+type Registry struct {
+	registry *tsratecalc.Registry[decimal]
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		registry: tsratecalc.NewRegistry[decimal](),
+	}
+}
+
+// Get returns the Calculator for the provided Config, building it if there's none for an equivalent Config.
+// See tsratecalc.Registry.Get for details about equivalent Configs.
+func (r *Registry) Get(cfg Config) (*Calculator, error) {
+	underlyingCfg, err := underlyingConfig(cfg)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	calc, err := r.registry.Get(underlyingCfg)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &Calculator{
+		calc: calc,
+	}, nil
+}
+
+// Evict removes the Calculator for the provided Config, returning false if there's none.
+func (r *Registry) Evict(cfg Config) bool {
+	underlyingCfg, err := underlyingConfig(cfg)
+	if err != nil {
+		return false
+	}
+
+	return r.registry.Evict(underlyingCfg)
+}
+
+// Len returns the number of calculators currently stored.
+func (r *Registry) Len() int {
+	return r.registry.Len()
+}
+
+// Metrics returns a snapshot of the Registry counters.
+func (r *Registry) Metrics() tsratecalc.RegistryMetrics {
+	return r.registry.Metrics()
+}
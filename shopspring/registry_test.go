@@ -0,0 +1,142 @@
+package shopspring_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+func TestRegistry_Get(t *testing.T) {
+	t.Parallel()
+
+	const goroutines = 16
+
+	registry := shopspring.NewRegistry()
+
+	rate := decimal.RequireFromString("0.1375")
+
+	var wg sync.WaitGroup
+
+	for i := range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			// Equivalent configs, with different radius representations and the default max terms cache.
+			cfg := shopspring.Config{
+				Root:              252,
+				Precision:         30,
+				ConvergenceRadius: decimal.New(9, -1),
+			}
+
+			if i%2 == 0 {
+				cfg.ConvergenceRadius = decimal.RequireFromString("0.90")
+				cfg.MaxTermsCache = tsratecalc.DefaultMaxTermsCache
+			}
+
+			calc, err := registry.Get(cfg)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+
+				return
+			}
+
+			_, err = calc.ComputeRate(rate)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	metrics := registry.Metrics()
+
+	if metrics.Misses != 1 || metrics.Hits != goroutines-1 {
+		t.Fatalf("unexpected metrics: got %d misses and %d hits, want 1 and %d", metrics.Misses, metrics.Hits, goroutines-1)
+	}
+
+	if metrics.Entries != 1 || metrics.BuildTime <= 0 {
+		t.Fatalf("unexpected metrics: got %d entries and %s build time", metrics.Entries, metrics.BuildTime)
+	}
+}
+
+func TestRegistry_Evict(t *testing.T) {
+	t.Parallel()
+
+	registry := shopspring.NewRegistry()
+
+	cfg := shopspring.Config{
+		Root:              252,
+		Precision:         10,
+		ConvergenceRadius: decimal.New(9, -1),
+	}
+
+	if registry.Evict(cfg) {
+		t.Fatalf("unexpected eviction of a missing calculator")
+	}
+
+	_, err := registry.Get(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if !registry.Evict(cfg) {
+		t.Fatalf("expected calculator to be evicted")
+	}
+
+	if registry.Len() != 0 {
+		t.Fatalf("unexpected registry length: got %d, want 0", registry.Len())
+	}
+
+	_, err = registry.Get(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	metrics := registry.Metrics()
+	if metrics.Misses != 2 || metrics.Evictions != 1 || metrics.Entries != 1 {
+		t.Fatalf("unexpected metrics: %+v", metrics)
+	}
+}
+
+func TestRegistry_Get_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	registry := shopspring.NewRegistry()
+
+	cfg := shopspring.Config{
+		Root:      252,
+		Precision: 30,
+		// The Taylor series diverges outside the (-1, 1) interval.
+		ConvergenceRadius: decimal.New(2, 0),
+	}
+
+	for range 2 {
+		_, err := registry.Get(cfg)
+		if !errors.Is(err, tsratecalc.ErrConfigTermsExceedMaxTermsCache) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if registry.Len() != 0 {
+		t.Fatalf("invalid configs should not be stored: got %d entries", registry.Len())
+	}
+
+	// The config is rejected by the terms estimate, before starting any build.
+	metrics := registry.Metrics()
+	if metrics.Misses != 0 || metrics.BuildErrors != 0 {
+		t.Fatalf("unexpected metrics: %+v", metrics)
+	}
+
+	_, err := registry.Get(shopspring.Config{Root: -1})
+	if !errors.Is(err, shopspring.ErrRootNegative) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,585 @@
+// Code generated by tsratecalc-gen -root 252 -precision 30 -radius 0.9 -package tables -name Business252. DO NOT EDIT.
+
+package tables
+
+import (
+	"slices"
+
+	shopspringdecimal "github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+// Business252Config returns the Config used to generate the Business252 Taylor terms.
+func Business252Config() shopspring.Config {
+	return shopspring.Config{
+		Root:              252,
+		Precision:         30,
+		ConvergenceRadius: shopspringdecimal.RequireFromString("0.9"),
+	}
+}
+
+// NewBusiness252 returns a Calculator for Business252Config, using the precomputed Taylor terms.
+func NewBusiness252() (*shopspring.Calculator, error) {
+	return shopspring.NewCalculatorFromTerms(Business252Config(), business252Terms)
+}
+
+// Business252Terms returns a copy of the precomputed Taylor terms for Business252Config, in their String() representation.
+func Business252Terms() []string {
+	return slices.Clone(business252Terms)
+}
+
+// business252Terms are the 550 precomputed Taylor terms for Business252Config.
+// It's shared by every call, so it must not be modified: Business252Terms returns a copy instead.
+var business252Terms = []string{
+	"0.0039682539682539682539682539683",
+	"-0.0019762534643487024439405391787",
+	"0.0013148882176817424990768402207",
+	"-0.0009848617106644003837331491733",
+	"0.0007871077322532152273168898551",
+	"-0.0006554025363140198222169076241",
+	"0.0005614020591669410155157298299",
+	"-0.0004909483285274389932312657193",
+	"0.0004361820467296250314642859014",
+	"-0.0003923907539428809310831492613",
+	"0.0003565773121147608461033380192",
+	"-0.0003267446203273817144683695937",
+	"0.0003015106798686431388394021006",
+	"-0.0002798887405243215078512023468",
+	"0.0002611554465156830577225372162",
+	"-0.0002447684604123924293485784077",
+	"0.0002303131801872908222932118491",
+	"-0.0002174672289995958094977571318",
+	"0.0002059761661472779858129341254",
+	"-0.000195636489552980102794943583",
+	"0.000186283497894457055552479349",
+	"-0.000177782465252448102620520966",
+	"0.000170022119546984098141053781",
+	"-0.0001629097524429187911255632706",
+	"0.0001563675036543380841168700155",
+	"-0.0001503295032842911464975830628",
+	"0.0001447396496201339360237605298",
+	"-0.0001395498634305231245421829484",
+	"0.0001347187036812179315332650111",
+	"-0.0001302102602908279663642284968",
+	"0.0001259932613336365332497699958",
+	"-0.0001220403477526085021346668449",
+	"0.000118327480029976232889829097",
+	"-0.0001148334496322656835292867579",
+	"0.0001115394742629954774144444053",
+	"-0.0001084288606178744615650336431",
+	"0.000105486721864515148096999161",
+	"-0.0001026997397601164082819886359",
+	"0.0001000559633906303021265813176",
+	"-0.0000975446381190202360116978777",
+	"0.0000951560595820368717346015205",
+	"-0.0000928814485583921883872041108",
+	"0.0000907128433087361138521392676",
+	"-0.0000886430066062550318892432328",
+	"0.0000866653451713888481971816333",
+	"-0.0000847738396220132979389098119",
+	"0.0000829629833720665430943856493",
+	"-0.0000812277291728988152998354203",
+	"0.0000795634422049085820417484134",
+	"-0.000077965858801905258926148227",
+	"0.0000764310500346408619055821283",
+	"-0.0000749553894990208879997432792",
+	"0.0000735355247533446163118176241",
+	"-0.0000721683519312843438304177226",
+	"0.0000708509931261894708874815261",
+	"-0.0000695807762001034493359981909",
+	"0.0000683552167195530335268915672",
+	"-0.0000671720017612849083570568952",
+	"0.0000660289753659657610733378076",
+	"-0.0000649241254474744027696635705",
+	"0.0000638555719906560952039125372",
+	"-0.0000628215563919850767651907072",
+	"0.0000618204318160734980034375421",
+	"-0.0000608506544568555791669500856",
+	"0.0000599107756059651969002078163",
+	"-0.000058999434442646943243657036",
+	"0.0000581153514697738280671203605",
+	"-0.0000572573225294229423002563636",
+	"0.0000564242133391800158911256493",
+	"-0.0000556149544970704612414400036",
+	"0.0000548285369088880989178269743",
+	"-0.0000540640075968318440111795854",
+	"0.0000533204658528658483308779744",
+	"-0.0000525970597041756370681306488",
+	"0.0000518929826615747674290704872",
+	"-0.0000512074707247860030097119433",
+	"0.0000505397996212315369840751096",
+	"-0.0000498892822573644440426337684",
+	"0.0000492552663636979178048004178",
+	"-0.0000486371323165741294513969205",
+	"0.000048034291121390254537071846",
+	"-0.0000474461825434909255398845068",
+	"0.0000468722733742662552319101914",
+	"-0.0000463120558211819127066988687",
+	"0.0000457650460115292972111435553",
+	"-0.0000452307826006342754229182268",
+	"0.000044708825476115005596153115",
+	"-0.0000441987545505442490838954158",
+	"0.0000437001686355590656070706637",
+	"-0.0000432126843910795046018418772",
+	"0.0000427359353438554022704156607",
+	"-0.0000422695709700633294281789819",
+	"0.0000418132558371299780736249077",
+	"-0.0000413666688003690069298969821",
+	"0.0000409295022503901698892426823",
+	"-0.0000405014614075764830100273054",
+	"0.0000400822636602308419446723345",
+	"-0.000039671637943271075058860782",
+	"0.0000392693241546047458144367088",
+	"-0.0000388750726065446267393949435",
+	"0.0000384886435098349068523500385",
+	"-0.0000381098064880488723272315916",
+	"0.0000377383401202928095787807674",
+	"-0.0000373740315103098241993763285",
+	"0.0000370166758802225836278554589",
+	"-0.0000366660761872869624217365823",
+	"0.0000363220427621503505877097556",
+	"-0.0000359843929672200140687984325",
+	"0.000035652950873849304022220378",
+	"-0.0000353275469571435365648747158",
+	"0.0000350080178072737626927556931",
+	"-0.0000346942058562661003500237206",
+	"0.0000343859591193074220458884488",
+	"-0.000034083130949675537550705708",
+	"0.0000337855798064640844451043089",
+	"-0.0000334931690343295943874752931",
+	"0.0000332057666545410518769600561",
+	"-0.0000329232451666610785744018474",
+	"0.0000326454813602329989132265884",
+	"-0.0000323723561358897797093560088",
+	"0.0000321037543353394676843505296",
+	"-0.0000318395645797175289215304449",
+	"0.0000315796791158296551695254377",
+	"-0.0000313239936698393549020558417",
+	"0.0000310724073079831848452234567",
+	"-0.0000308248223039229761728298584",
+	"0.0000305811440123690293858117996",
+	"-0.0000303412807486311398634094439",
+	"0.0000301051436737756065059145937",
+	"-0.0000298726466850861917181857661",
+	"0.0000296437063115454548193398616",
+	"-0.0000294182416140700760293779513",
+	"0.0000291961740902498191599593864",
+	"-0.0000289774275833547349308824578",
+	"0.0000287619281953891632689920033",
+	"-0.000028549604203984125386549923",
+	"0.0000283403859829318723532166728",
+	"-0.0000281342059261777383612289853",
+	"0.0000279309983750950911131591288",
+	"-0.0000277306995488791314178181634",
+	"0.0000275332474779046177330070637",
+	"-0.0000273385819399013238506448128",
+	"0.0000271466443988092205391170867",
+	"-0.0000269573779461830429378054513",
+	"0.0000267707272450231001391392012",
+	"-0.0000265866384759159343331160925",
+	"0.0000264050592853747743237683338",
+	"-0.0000262259387362756801071254394",
+	"0.0000260492272602908674122521376",
+	"-0.0000258748766122259576383024077",
+	"0.0000257028398261728417105590431",
+	"-0.000025533071173394496656445336",
+	"0.0000253655261218624703141359504",
+	"-0.0000252001612973718703092535449",
+	"0.0000250369344461625747847210014",
+	"-0.0000248758043989790396956760682",
+	"0.0000247167310365045250600728419",
+	"-0.0000245596752561088136666581737",
+	"0.0000244045989398515627467803531",
+	"-0.0000242514649236863235261718355",
+	"0.0000241002369678129961118929862",
+	"-0.0000239508797281290678340096596",
+	"0.0000238033587287324212724650972",
+	"-0.0000236576403354308024527318977",
+	"0.0000235136917302152182050036348",
+	"-0.0000233714808866565920287303053",
+	"0.0000232309765461869570657213526",
+	"-0.0000230921481952283095756980272",
+	"0.0000229549660431339928122282329",
+	"-0.0000228194010009091351982811334",
+	"0.0000226854246606782336108596833",
+	"-0.00002255300927586945746875126",
+	"0.0000224221277420866569196276232",
+	"-0.0000222927535786413931858047399",
+	"0.0000221648609107185752158272206",
+	"-0.0000220384244521504881052210634",
+	"0.0000219134194887751389551309161",
+	"-0.0000217898218623559283666779082",
+	"0.0000216676079550406838451569028",
+	"-0.0000215467546743390680379958645",
+	"0.0000214272394385983027960943265",
+	"-0.0000213090401629580322002585541",
+	"0.0000211921352457659864428930971",
+	"-0.0000210765035554369061597225495",
+	"0.000020962124417737945686569186",
+	"-0.0000208489776034844958602613262",
+	"0.0000207370433166310543558761697",
+	"-0.0000206263021827424260049804277",
+	"0.0000205167352378311588138478996",
+	"-0.0000204083239175477151384113182",
+	"0.0000203010500467104432209755569",
+	"-0.0000201948958291629535108460344",
+	"0.0000200898438379470182522259989",
+	"-0.0000199858770057796030252211475",
+	"0.0000198829786158231064935691135",
+	"-0.0000197811322927383307010749244",
+	"0.0000196803219940101299584399241",
+	"-0.0000195805320015360927033596511",
+	"0.0000194817469134689986726116653",
+	"-0.0000193839516363041642149892298",
+	"0.0000192871313772031424676467305",
+	"-0.0000191912716365455832376389782",
+	"0.0000190963582007013805525361034",
+	"-0.000019002377135015544704423132",
+	"0.0000189093147769985309063159107",
+	"-0.0000188171577297150390678335189",
+	"0.0000187258928553645693016315044",
+	"-0.000018635507269047276184390342",
+	"0.000018545988332708912075735167",
+	"-0.0000184573236492588864756754354",
+	"0.0000183695010568556949786780329",
+	"-0.0000182825086233541883369139279",
+	"0.0000181963346409093599285146578",
+	"-0.0000181109676207315289675683181",
+	"0.0000180263962879879874978600349",
+	"-0.0000179426095768463619680805194",
+	"0.0000178595966256551153588726273",
+	"-0.0000177773467722567837696452577",
+	"0.0000176958495494297024060567919",
+	"-0.0000176150946804541303514259772",
+	"0.0000175350720747988316554030157",
+	"-0.0000174557718239243124145761716",
+	"0.0000173771841971990499218562668",
+	"-0.0000172992996379251808807878563",
+	"0.0000172221087594702413611610604",
+	"-0.0000171456023415016718453517166",
+	"0.000017069771326320916601367485",
+	"-0.000016994606815294057928641469",
+	"0.0000169201000653760327561186595",
+	"-0.0000168462424857255818194848082",
+	"0.0000167730256344081803867848554",
+	"-0.0000167004412151842944118625687",
+	"0.0000166284810743803972375625273",
+	"-0.0000165571371978402697022704482",
+	"0.0000164864017079541908735863141",
+	"-0.0000164162668607637077842162351",
+	"0.0000163467250431397506134070678",
+	"-0.0000162777687700319348720239814",
+	"0.0000162093906817869644343102591",
+	"-0.0000161415835415341188324273144",
+	"0.000016074340232635875203622004",
+	"-0.0000160076537562017797617599358",
+	"0.000015941517228663745757583875",
+	"-0.0000158759238794110146933653491",
+	"0.0000158108670484830751611751736",
+	"-0.0000157463401843188891691797981",
+	"0.0000156823368415608292925683578",
+	"-0.0000156188506789117815165343749",
+	"0.0000155558754570439183061741531",
+	"-0.0000154934050365576943167858664",
+	"0.0000154314333759896633191550117",
+	"-0.0000153699545298677594261758888",
+	"0.000015308962646812728634802175",
+	"-0.0000152484519676844381032341686",
+	"0.000015188416823771830527135223",
+	"-0.0000151288516350253295176584557",
+	"0.0000150697509083305390728439355",
+	"-0.0000150111092358221161248706748",
+	"0.0000149529212932367297888470142",
+	"-0.0000148951818383040543823058315",
+	"0.0000148378857091747755743218356",
+	"-0.0000147810278228846202032400559",
+	"0.0000147246031738534504146073723",
+	"-0.0000146686068324184918564830387",
+	"0.0000146130339434007937666404181",
+	"-0.0000145578797247040459324275045",
+	"0.0000145031394659449037348633858",
+	"-0.0000144488085271139978380835878",
+	"0.0000143948823372668295862833408",
+	"-0.0000143413563932437768542826105",
+	"0.000014288226258418457994912062",
+	"-0.0000142354875614737236655365842",
+	"0.0000141831359952045677249676448",
+	"-0.0000141311673153472690974271394",
+	"0.0000140795773394340965277063737",
+	"-0.0000140283619456729275257868068",
+	"0.0000139775170718511515435504871",
+	"-0.0000139270387142632455634700047",
+	"0.0000138769229266614278310987538",
+	"-0.0000138271658192288124506952959",
+	"0.0000137777635575745040065046798",
+	"-0.0000137287123617500872903946145",
+	"0.0000136800085052869826282645197",
+	"-0.0000136316483142541522207506738",
+	"0.0000135836281663356573653921397",
+	"-0.00001353594448992758042409116",
+	"0.0000134885937632538389572572588",
+	"-0.0000134415725135004325797194215",
+	"0.0000133948773159676758179977071",
+	"-0.0000133485047932399825779600303",
+	"0.000013302451614372779779833375",
+	"-0.0000132567144940961392970613343",
+	"0.0000132112901920347285591806728",
+	"-0.0000131661755119436910588368559",
+	"0.0000131213673009600785509287886",
+	"-0.0000130768624488694669588896291",
+	"0.0000130326578873873979200809193",
+	"-0.0000129887505894552975196097827",
+	"0.000012945137568550533089598883",
+	"-0.0000129018158780102779987037968",
+	"0.0000128587826103688631337869579",
+	"-0.000012816034896708302291086862",
+	"0.000012773569906021686956605682",
+	"-0.0000127313848445891539731049871",
+	"0.0000126894769553661373720745999",
+	"-0.0000126478435173836232010618538",
+	"0.0000126064818451601335072783285",
+	"-0.0000125653892881251727546327217",
+	"0.0000125245632300538768602098256",
+	"-0.0000124840010885126117444183114",
+	"0.0000124437003143152748030191149",
+	"-0.0000124036583909900590352489196",
+	"0.0000123638728342564457062779021",
+	"-0.0000123243411915121973900852988",
+	"0.0000122850610413301290360957349",
+	"-0.0000122460299929644403349940143",
+	"0.0000122072456858663981312391511",
+	"-0.0000121687057892091629469623778",
+	"0.0000121304080014215588490176413",
+	"-0.0000120923500497305909126485755",
+	"0.0000120545296897125194160741295",
+	"-0.0000120169447048523046446521296",
+	"0.0000119795929061112407953831608",
+	"-0.000011942472131502601956449789",
+	"0.000011905580245675127496193601",
+	"-0.0000118689151395041784352268932",
+	"0.0000118324747296904004979409313",
+	"-0.0000117962569583657335490687495",
+	"0.0000117602597927066110206286184",
+	"-0.0000117244812245541967278409916",
+	"0.0000116889192700415101626927663",
+	"-0.0000116535719692272949438272893",
+	"0.0000116184373857364885943732125",
+	"-0.0000115835136064071552180974733",
+	"0.000011548798740943745951689292",
+	"-0.0000115142909215765552897729869",
+	"0.0000114799883027272445120386295",
+	"-0.0000114458890606803064912164991",
+	"0.0000114119913932603491289666774",
+	"-0.0000113782935195150775564920209",
+	"0.0000113447936794038580501173004",
+	"-0.0000113114901334917493514414478",
+	"0.0000112783811626488897491239564",
+	"-0.0000112454650677551308770017669",
+	"0.000011212740169409811713073984",
+	"-0.0000111802048076465687278986501",
+	"0.00001114785734165308053101655",
+	"-0.0000111156961494956477019896025",
+	"0.0000110837196278485107702958361",
+	"-0.0000110519261917278115273833095",
+	"0.0000110203142742301050163216266",
+	"-0.0000109888823262753316513197172",
+	"0.0000109576288163541609724696802",
+	"-0.0000109265522302796205419473635",
+	"0.0000108956510709429254380225627",
+	"-0.0000108649238580734257040313496",
+	"0.0000108343691280025909623222222",
+	"-0.0000108039854334319532094461523",
+	"0.000010773771343204930569816782",
+	"-0.0000107437254420824565019798811",
+	"0.0000107138463305223406257212421",
+	"-0.0000106841326244622889706928781",
+	"0.0000106545829551065130391962528",
+	"-0.0000106251959687158586283411524",
+	"0.0000105959703264013868710790268",
+	"-0.0000105669047039213414326370219",
+	"0.0000105379977914814372396689716",
+	"-0.0000105092482935384075249774144",
+	"0.0000104806549286067473419019715",
+	"-0.0000104522164290685930403414695",
+	"0.000010423931540986678501779813",
+	"-0.0000103957990239203102044919835",
+	"0.0000103678176507443044331640922",
+	"-0.0000103399862074708311602926199",
+	"0.0000103123034930741103107312016",
+	"-0.0000102847683193179072764035357",
+	"0.0000102573795105857756762505938",
+	"-0.0000102301359037139964576597292",
+	"0.0000102030363478271635106418049",
+	"-0.0000101760797041763670155687851",
+	"0.0000101492648459799267700271976",
+	"-0.0000101225906582666287409320334",
+	"0.000010096056037721419065111902",
+	"-0.0000100696598925335106757324242",
+	"0.0000100434011422468586637662285",
+	"-0.0000100172787176129613938228444",
+	"0.0000099912915604459452825821531",
+	"-0.0000099654386234798920163768135",
+	"0.0000099397188702283678326727358",
+	"-0.0000099141312748461153188177683",
+	"0.0000098886748219928689909683296",
+	"-0.0000098633485066992567070487172",
+	"0.0000098381513342347497404215986",
+	"-0.0000098130823199776250961108393",
+	"0.0000097881404892869043893666556",
+	"-0.0000097633248773762343275329439",
+	"0.0000097386345291896745409903643",
+	"-0.0000097140684992793591978174505",
+	"0.0000096896258516849995101354696",
+	"-0.0000096653056598151948982697526",
+	"0.0000096411070063305212222488698",
+	"-0.0000096170289830283651191411054",
+	"0.0000095930706907294740996529147",
+	"-0.0000095692312391661926586344074",
+	"0.0000095455097468723552419909182",
+	"-0.000009521905341074807487316748",
+	"0.0000094984171575865277176676398",
+	"-0.0000094750443407013212175842878",
+	"0.0000094517860430900603580735879",
+	"-0.0000094286414256984441630426876",
+	"0.0000094056096576462514239505604",
+	"-0.000009382689916128061972472487",
+	"0.0000093598813863154212130367329",
+	"-0.0000093371832612604234984548537",
+	"0.0000093145947418006904027854229",
+	"-0.0000092921150364657204062967103",
+	"0.000009269743361384586958171467",
+	"-0.0000092474789401949623236645807",
+	"0.0000092253210039534450540137952",
+	"-0.0000092032687910471693397406896",
+	"0.0000091813215471066749212835544",
+	"-0.0000091594785249200166353898063",
+	"0.0000091377389843480930715717117",
+	"-0.0000091161021922411742003985718",
+	"0.0000090945674223566082146590682",
+	"-0.0000090731339552776881956719162",
+	"0.0000090518010783336595804391647",
+	"-0.0000090305680855208497611073601",
+	"0.000009009434277424901496505664",
+	"-0.0000089883989611440921565405853",
+	"0.0000089674614502137211541135536",
+	"-0.000008946621064531548246155085",
+	"0.0000089258771302842657054985613",
+	"-0.0000089052289798749876788043625",
+	"0.0000088846759518517403527439948",
+	"-0.0000088642173908369368513128365",
+	"0.0000088438526474578210816043065",
+	"-0.0000088235810782778650337891485",
+	"0.0000088034020457291043235390431",
+	"-0.0000087833149180453970418484282",
+	"0.0000087633190691965912482733315",
+	"-0.0000087434138788235867091491022",
+	"0.0000087235987321742767424948444",
+	"-0.000008703873020040356286182718",
+	"0.0000086842361386949825556636862",
+	"-0.0000086646874898312749022134147",
+	"0.000008645226480501640722405702",
+	"-0.0000086258525230579145044460875",
+	"0.0000086065650350922973272125097",
+	"-0.0000085873634393790843534577939",
+	"0.0000085682471638171680797325201",
+	"-0.0000085492156413733053222861413",
+	"0.0000085302683100261361305963625",
+	"-0.0000085114046127109430283566513",
+	"0.0000084926239972651391858119606",
+	"-0.000008473925916374474327363666",
+	"0.0000084553098275199473744545711",
+	"-0.0000084367751929254150159796889",
+	"0.0000084183214795058855869323909",
+	"-0.0000083999481588164878207704353",
+	"0.0000083816547070021042221523955",
+	"-0.0000083634406047476589843302578",
+	"0.0000083453053372290505496647263",
+	"-0.0000083272483940647190825305482",
+	"0.0000083092692692678392913726695",
+	"-0.0000082913674611991292009312456",
+	"0.0000082735424725202656367437979",
+	"-0.0000082557938101478973420238126",
+	"0.0000082381209852082468019729443",
+	"-0.0000082205235129922920025732554",
+	"0.000008203000912911519499989669",
+	"-0.0000081855527084542403229525979",
+	"0.0000081681784271424603739467",
+	"-0.0000081508776004892971357626491",
+	"0.0000081336497639569346280320897",
+	"-0.0000081164944569151086938176363",
+	"0.0000080994112226001148292246455",
+	"-0.0000080823996080743308993930569",
+	"0.0000080654591641862472121681303",
+	"-0.0000080485894455309965462895049",
+	"0.0000080317900104113768541285699",
+	"-0.0000080150604207993594798934506",
+	"0.0000079984002422980758528566351",
+	"-0.0000079818090441042757315889851",
+	"0.0000079652863989712501894511025",
+	"-0.000007948831883172212643743258",
+	"0.0000079324450764641313409918236",
+	"-0.0000079161255620520068188958786",
+	"0.0000078998729265535879715139471",
+	"-0.000007883686759964520448378273",
+	"0.0000078675666556239212204223707",
+	"-0.0000078515122101803732459356074",
+	"0.0000078355230235583342682542453",
+	"-0.0000078195986989249538735987899",
+	"0.0000078037388426572930324089511",
+	"-0.000007787943064309940440745489",
+	"0.0000077722109765830200698573936",
+	"-0.0000077565421952905844218871482",
+	"0.0000077409363393293880779394318",
+	"-0.000007725393030648036211401982",
+	"0.0000077099118942165028245131826",
+	"-0.0000076944925579960135497503147",
+	"0.0000076791346529092879396956677",
+	"-0.0000076638378128111362496545443",
+	"0.0000076486016744594057964786634",
+	"-0.0000076334258774862720548189836",
+	"0.0000076183100643698697284213347",
+	"-0.0000076032538804062591091136654",
+	"0.0000075882569736817231098417834",
+	"-0.0000075733189950453904305172427",
+	"0.0000075584395980821803865719684",
+	"-0.0000075436184390860649999942565",
+	"0.0000075288551770336440212743197",
+	"-0.0000075141494735580286181384541",
+	"0.0000074995009929230295332225269",
+	"-0.0000074849094019976455779507069",
+	"0.0000074703743702308483938665405",
+	"-0.0000074558955696266594755325313",
+	"0.0000074414726747195155108927346",
+	"-0.0000074271053625499181557015268",
+	"0.0000074127933126403644182811776",
+	"-0.0000073985362069715538895012626",
+	"0.00000738433372995886911049398",
+	"-0.0000073701855684291254272503491",
+	"0.0000073560914115975867369019414",
+	"-0.0000073420509510452435851996948",
+	"0.0000073280638806963501284735757",
+	"-0.0000073141298967962165262120932",
+	"0.0000073002486978892533823562731",
+	"-0.0000072864199847972649044756492",
+	"0.0000072726434605979875002007535",
+	"-0.0000072589188306038705796437748",
+	"0.0000072452458023410963810624451",
+	"-0.0000072316240855288356847274413",
+	"0.0000072180533920587363268559382",
+	"-0.0000072045334359746414715884176",
+	"0.0000071910639334525346443270902",
+	"-0.0000071776446027807085743367176",
+	"0.0000071642751643401549383462945",
+	"-0.0000071509553405851721399967823",
+	"0.0000071376848560241883023693787",
+	"-0.0000071244634372007966925139092",
+	"0.0000071112908126750008378908128",
+	"-0.0000070981667130046666349555714",
+	"0.0000070850908707271787897637572",
+	"-0.0000070720630203412989694703518",
+	"0.0000070590828982892230819505759",
+	"-0.0000070461502429388351384928834",
+	"0.0000070332647945661551916195011",
+	"-0.0000070204262953379788765871798",
+}
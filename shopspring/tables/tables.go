@@ -0,0 +1,6 @@
+// Package tables provides calculators with precomputed Taylor terms for common configurations.
+//
+// The tables are generated by cmd/tsratecalc-gen, so creating these calculators doesn't compute any Taylor term at runtime.
+package tables
+
+//go:generate go run ../../cmd/tsratecalc-gen -root 252 -precision 30 -radius 0.9 -package tables -name Business252 -o business252.go
@@ -0,0 +1,67 @@
+package tables_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc/shopspring"
+	"github.com/mqzabin/tsratecalc/shopspring/tables"
+)
+
+func TestNewBusiness252(t *testing.T) {
+	t.Parallel()
+
+	precomputed, err := tables.NewBusiness252()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	calc, err := shopspring.NewCalculator(tables.Business252Config())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if precomputed.TermsCacheLen() != calc.TermsCacheLen() {
+		t.Fatalf("unexpected terms cache length: got %d, want %d", precomputed.TermsCacheLen(), calc.TermsCacheLen())
+	}
+
+	for _, rate := range []string{"0", "0.1", "-0.1", "0.1375", "0.85", "-0.5"} {
+		got, err := precomputed.ComputeRate(decimal.RequireFromString(rate))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		want, err := calc.ComputeRate(decimal.RequireFromString(rate))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if !got.Equal(want) {
+			t.Fatalf("unexpected result for rate '%s': got '%s', want '%s'", rate, got, want)
+		}
+	}
+}
+
+func TestBusiness252Terms(t *testing.T) {
+	t.Parallel()
+
+	terms := tables.Business252Terms()
+
+	precomputed, err := tables.NewBusiness252()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if len(terms) != precomputed.TermsCacheLen() {
+		t.Fatalf("unexpected terms length: got %d, want %d", len(terms), precomputed.TermsCacheLen())
+	}
+
+	first := terms[0]
+	terms[0] = "0"
+
+	// Each call returns a copy, so the shared table isn't modified.
+	if got := tables.Business252Terms()[0]; got != first {
+		t.Fatalf("unexpected first term: got '%s', want '%s'", got, first)
+	}
+}
@@ -0,0 +1,119 @@
+package shopspring
+
+import (
+	"io"
+
+	shopspring "github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+)
+
+// Trace is the "github.com/shopspring/decimal".Decimal equivalent of tsratecalc.Trace.
+type Trace struct {
+	// Root is the "n" in the formula: "(1+x)^(1/n)-1".
+	Root uint64
+	// Rate is the computed rate.
+	Rate shopspring.Decimal
+	// Places is the number of decimal places of the result.
+	Places uint64
+	// MaxError is the maximum error accepted for the last term.
+	MaxError shopspring.Decimal
+	// FixedTerms is the number of terms precomputed by eager caches for the |rate| interval.
+	// It's zero if the number of terms was decided by the error of each term (e.g. lazy caches).
+	FixedTerms int
+	// Evaluation is the scheme used to evaluate the fixed number of terms.
+	Evaluation tsratecalc.Evaluation
+	// Steps are the evaluated Taylor terms.
+	Steps []TraceStep
+	// Result is the computed result, the same returned by ComputeRateWithPrecision.
+	Result shopspring.Decimal
+
+	trace *tsratecalc.Trace[decimal]
+}
+
+// TraceStep is the "github.com/shopspring/decimal".Decimal equivalent of tsratecalc.TraceStep.
+type TraceStep struct {
+	// Term is the index of the Taylor term, starting at 1.
+	Term uint64
+	// Coefficient is the constant part of the Taylor term.
+	Coefficient shopspring.Decimal
+	// Power is "rate^Term".
+	Power shopspring.Decimal
+	// Value is "Coefficient * Power".
+	Value shopspring.Decimal
+	// Sum is the sum of the values so far.
+	Sum shopspring.Decimal
+	// Error is the absolute value of Value, the approximation error of the previous Sum.
+	Error shopspring.Decimal
+	// Stop is true for the last term.
+	Stop bool
+}
+
+// newTrace converts the tsratecalc.Trace. It returns nil for a nil trace.
+func newTrace(t *tsratecalc.Trace[decimal]) *Trace {
+	if t == nil {
+		return nil
+	}
+
+	steps := make([]TraceStep, 0, len(t.Steps))
+
+	for _, step := range t.Steps {
+		steps = append(steps, TraceStep{
+			Term:        step.Term,
+			Coefficient: step.Coefficient.d,
+			Power:       step.Power.d,
+			Value:       step.Value.d,
+			Sum:         step.Sum.d,
+			Error:       step.Error.d,
+			Stop:        step.Stop,
+		})
+	}
+
+	return &Trace{
+		Root:       t.Root,
+		Rate:       t.Rate.d,
+		Places:     t.Places,
+		MaxError:   t.MaxError.d,
+		FixedTerms: t.FixedTerms,
+		Evaluation: t.Evaluation,
+		Steps:      steps,
+		Result:     t.Result.d,
+		trace:      t,
+	}
+}
+
+// WriteCSV writes the trace steps as CSV, with a header row. See tsratecalc.Trace.WriteCSV for details.
+func (t *Trace) WriteCSV(w io.Writer) error {
+	return t.trace.WriteCSV(w)
+}
+
+// MarshalJSON implements the json.Marshaler interface. See tsratecalc.Trace.MarshalJSON for details.
+func (t *Trace) MarshalJSON() ([]byte, error) {
+	return t.trace.MarshalJSON()
+}
+
+// ComputeRateTrace is the same as ComputeRate, but it also returns the Trace of the computation.
+// See tsratecalc.Calculator.ComputeRateWithPrecisionTrace for details.
+func (c *Calculator) ComputeRateTrace(rate shopspring.Decimal) (shopspring.Decimal, *Trace, error) {
+	result, trace, err := c.calc.ComputeRateTrace(decimal{d: rate})
+	if err != nil {
+		return shopspring.Decimal{}, newTrace(trace), translateError(err)
+	}
+
+	return result.d, newTrace(trace), nil
+}
+
+// ComputeRateWithPrecisionTrace is the same as ComputeRateWithPrecision, but it also returns the Trace of the computation.
+// See tsratecalc.Calculator.ComputeRateWithPrecisionTrace for details.
+func (c *Calculator) ComputeRateWithPrecisionTrace(rate shopspring.Decimal, precision int32) (shopspring.Decimal, *Trace, error) {
+	if precision < 0 {
+		return shopspring.Decimal{}, nil, ErrConfigPrecisionNegative
+	}
+
+	result, trace, err := c.calc.ComputeRateWithPrecisionTrace(decimal{d: rate}, uint64(precision))
+	if err != nil {
+		return shopspring.Decimal{}, newTrace(trace), translateError(err)
+	}
+
+	return result.d, newTrace(trace), nil
+}
@@ -0,0 +1,138 @@
+package shopspring_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/shopspring"
+)
+
+func TestCalculator_ComputeRateTrace(t *testing.T) {
+	t.Parallel()
+
+	rates := []string{"0", "0.0001", "-0.0001", "0.1375", "-0.5", "0.85"}
+
+	for _, lazy := range []bool{false, true} {
+		calc, err := shopspring.NewCalculator(shopspring.Config{
+			Root:              252,
+			Precision:         30,
+			ConvergenceRadius: decimal.RequireFromString("0.9"),
+			LazyTermsCache:    lazy,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		for _, rate := range rates {
+			r := decimal.RequireFromString(rate)
+
+			got, trace, err := calc.ComputeRateTrace(r)
+			if err != nil {
+				t.Fatalf("unexpected error for rate '%s': %s", rate, err.Error())
+			}
+
+			want, err := calc.ComputeRate(r)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !got.Equal(want) || !trace.Result.Equal(want) {
+				t.Fatalf("unexpected result for rate '%s': got '%s', want '%s'", rate, got.String(), want.String())
+			}
+
+			// Eager caches could also use the adaptive evaluation near the convergence boundaries.
+			if lazy && trace.FixedTerms != 0 {
+				t.Fatalf("unexpected fixed terms for rate '%s' (lazy=%t): %d", rate, lazy, trace.FixedTerms)
+			}
+
+			last := trace.Steps[len(trace.Steps)-1]
+			if !last.Stop || !last.Sum.Truncate(30).Equal(want) {
+				t.Fatalf("unexpected last step for rate '%s': %+v", rate, last)
+			}
+
+			for i, step := range trace.Steps[:len(trace.Steps)-1] {
+				if step.Stop || step.Term != uint64(i+1) {
+					t.Fatalf("unexpected step for rate '%s': %+v", rate, step)
+				}
+			}
+
+			if trace.FixedTerms > 0 && len(trace.Steps) != trace.FixedTerms {
+				t.Fatalf("unexpected number of steps for rate '%s': got %d, want %d", rate, len(trace.Steps), trace.FixedTerms)
+			}
+
+			if trace.FixedTerms == 0 && last.Error.GreaterThan(trace.MaxError) {
+				t.Fatalf("unexpected last step error for rate '%s': %+v", rate, last)
+			}
+		}
+	}
+}
+
+func TestTrace_Render(t *testing.T) {
+	t.Parallel()
+
+	calc, err := shopspring.NewCalculator(shopspring.Config{
+		Root:              12,
+		Precision:         10,
+		ConvergenceRadius: decimal.RequireFromString("0.5"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	_, trace, err := calc.ComputeRateTrace(decimal.RequireFromString("0.1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var buf bytes.Buffer
+
+	err = trace.WriteCSV(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if len(records) != len(trace.Steps)+1 || records[0][0] != "term" || records[1][1] != trace.Steps[0].Coefficient.String() {
+		t.Fatalf("unexpected csv: %v", records)
+	}
+
+	data, err := json.Marshal(trace)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var decoded struct {
+		Root   uint64 `json:"root"`
+		Result string `json:"result"`
+		Steps  []struct {
+			Term uint64 `json:"term"`
+			Sum  string `json:"sum"`
+			Stop bool   `json:"stop"`
+		} `json:"steps"`
+	}
+
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if decoded.Root != 12 || decoded.Result != trace.Result.String() || len(decoded.Steps) != len(trace.Steps) ||
+		!decoded.Steps[len(decoded.Steps)-1].Stop {
+		t.Fatalf("unexpected json: %s", data)
+	}
+
+	_, trace, err = calc.ComputeRateTrace(decimal.RequireFromString("0.6"))
+	if !errors.Is(err, tsratecalc.ErrRateOutsideConvergenceBoundaries) || trace != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+package tsratecalc
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// termsCache stores the Taylor terms used by a Calculator.
+//
+// The terms slice is published with copy-on-write semantics: readers load it atomically and never block,
+// while growth (only possible on lazy caches) is serialized by a mutex. Terms are only appended, so a slice
+// loaded by a reader is never modified afterward.
+type termsCache[Decimal Operator[Decimal]] struct {
+	// terms is the published slice of Taylor terms.
+	terms atomic.Pointer[[]Decimal]
+	// maxTerms is the maximum number of terms that the cache could hold.
+	maxTerms uint64
+
+	// mu serializes the cache growth.
+	mu sync.Mutex
+	// generator computes the next terms. It's nil for caches that can't grow.
+	generator *termsGenerator[Decimal]
+}
+
+// newStaticTermsCache returns a termsCache that can't grow.
+func newStaticTermsCache[Decimal Operator[Decimal]](terms []Decimal) *termsCache[Decimal] {
+	c := &termsCache[Decimal]{
+		maxTerms: uint64(len(terms)),
+	}
+
+	c.terms.Store(&terms)
+
+	return c
+}
+
+// newLazyTermsCache returns an empty termsCache that grows on demand, up to maxTerms terms.
+func newLazyTermsCache[Decimal Operator[Decimal]](generator *termsGenerator[Decimal], maxTerms uint64) *termsCache[Decimal] {
+	c := &termsCache[Decimal]{
+		maxTerms:  maxTerms,
+		generator: generator,
+	}
+
+	c.terms.Store(&[]Decimal{})
+
+	return c
+}
+
+// load returns the current terms. It never blocks.
+func (c *termsCache[Decimal]) load() []Decimal {
+	return *c.terms.Load()
+}
+
+// grow extends the cache to hold at least minLen terms, limited by maxTerms, and returns the published terms.
+// If the cache can't grow, the current terms are returned.
+func (c *termsCache[Decimal]) grow(minLen uint64) ([]Decimal, error) {
+	if c.generator == nil {
+		return c.load(), nil
+	}
+
+	minLen = min(minLen, c.maxTerms)
+
+	// Fast path: another goroutine could have grown the cache already.
+	if terms := c.load(); uint64(len(terms)) >= minLen {
+		return terms, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	terms := c.load()
+
+	var err error
+
+	// The generator could be behind the cache, if its terms were replaced (e.g. by a persisted cache).
+	for c.generator.n < uint64(len(terms)) {
+		_, _, err = c.generator.next()
+		if err != nil {
+			return terms, fmt.Errorf("advancing taylor terms generator: %w", err)
+		}
+	}
+
+	for uint64(len(terms)) < minLen {
+		var term Decimal
+
+		_, term, err = c.generator.next()
+		if err != nil {
+			err = fmt.Errorf("growing taylor terms cache: %w", err)
+
+			break
+		}
+
+		// Appending only writes after the length seen by readers, so the loaded slices are never modified.
+		terms = append(terms, term)
+	}
+
+	c.terms.Store(&terms)
+
+	return terms, err
+}
+
+// growable returns true if the cache could grow beyond the published terms.
+func (c *termsCache[Decimal]) growable() bool {
+	return c.generator != nil
+}
+
+// replace publishes the provided terms, discarding the current ones.
+func (c *termsCache[Decimal]) replace(terms []Decimal) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// A generator ahead of the new terms would append the next ones at the wrong indices,
+	// so it's rewound, and grow catches it up again.
+	if c.generator != nil && c.generator.n > uint64(len(terms)) {
+		c.generator.reset()
+	}
+
+	c.terms.Store(&terms)
+}
+
+// clone returns a copy of the cache, cloning the published terms and the generator state.
+func (c *termsCache[Decimal]) clone() *termsCache[Decimal] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	terms := cloneDecimals(c.load())
+
+	cloned := &termsCache[Decimal]{
+		maxTerms: c.maxTerms,
+	}
+
+	if c.generator != nil {
+		cloned.generator = c.generator.clone()
+	}
+
+	cloned.terms.Store(&terms)
+
+	return cloned
+}
@@ -0,0 +1,208 @@
+package tsratecalc
+
+import "fmt"
+
+const (
+	// thresholdOctaves is the number of halvings of the convergence radius covered by the |rate| buckets.
+	thresholdOctaves = 24
+	// thresholdBucketsPerOctave is the number of |rate| buckets between each halving of the convergence radius.
+	thresholdBucketsPerOctave = 8
+	// thresholdGuardDigits is the number of extra decimal places used to bound the rate powers.
+	thresholdGuardDigits = 10
+)
+
+// termThresholds stores, for each |rate| bucket, how many Taylor terms guarantee an error lower than maxError.
+//
+// The buckets are finer near zero: each halving of the convergence radius is split in thresholdBucketsPerOctave buckets.
+type termThresholds[Decimal Operator[Decimal]] struct {
+	// bounds are the ascending upper bounds of each |rate| bucket. The last one is the convergence radius.
+	bounds []Decimal
+	// terms is the number of Taylor terms for each bucket.
+	// It's zero if the cache doesn't have enough terms, so the bucket requires the adaptive evaluation.
+	terms []int
+}
+
+// computeTermThresholds computes the number of terms for each |rate| bucket.
+//
+// For a bucket upper bound "b", it's the first "n" where "|c_n| * b^n <= maxError", which is the same stop condition
+// used by the adaptive evaluation. Since "|c_n| * x^n" decreases with "n" for "|x| < 1", it holds for every "|x| <= b".
+func computeTermThresholds[Decimal Operator[Decimal]](
+	radius Decimal,
+	taylorTerms []Decimal,
+	maxError Decimal,
+	workingPrecision uint64,
+	newFromInt func(n uint64) (Decimal, error),
+) (*termThresholds[Decimal], error) {
+	places := workingPrecision + thresholdGuardDigits
+
+	one, err := newFromInt(1)
+	if err != nil {
+		return nil, fmt.Errorf("creating '1' decimal: %w", err)
+	}
+
+	ten, err := newFromInt(10)
+	if err != nil {
+		return nil, fmt.Errorf("creating '10' decimal: %w", err)
+	}
+
+	scale, err := ten.PowInt(places)
+	if err != nil {
+		return nil, fmt.Errorf("computing 10^%d: %w", places, err)
+	}
+
+	// ulp is the smallest value at the bounding precision. It's added after each truncation to keep an upper bound.
+	ulp, err := one.DivRound(scale, places)
+	if err != nil {
+		return nil, fmt.Errorf("computing 10^-%d: %w", places, err)
+	}
+
+	absTerms := make([]Decimal, len(taylorTerms))
+
+	for i, term := range taylorTerms {
+		absTerms[i], err = term.Abs()
+		if err != nil {
+			return nil, fmt.Errorf("computing taylor term %d absolute value: %w", i+1, err)
+		}
+	}
+
+	t := &termThresholds[Decimal]{
+		bounds: make([]Decimal, 0, thresholdOctaves*thresholdBucketsPerOctave),
+		terms:  make([]int, 0, thresholdOctaves*thresholdBucketsPerOctave),
+	}
+
+	for octave := thresholdOctaves - 1; octave >= 0; octave-- {
+		for bucket := 1; bucket <= thresholdBucketsPerOctave; bucket++ {
+			bound, err := thresholdBound(radius, octave, bucket, places, newFromInt)
+			if err != nil {
+				return nil, err
+			}
+
+			n, err := thresholdTerms(bound, absTerms, maxError, ulp, places)
+			if err != nil {
+				return nil, fmt.Errorf("computing terms for |rate| <= '%s': %w", bound.String(), err)
+			}
+
+			t.bounds = append(t.bounds, bound)
+			t.terms = append(t.terms, n)
+		}
+	}
+
+	return t, nil
+}
+
+// thresholdBound returns "radius * (1 + bucket/bucketsPerOctave) / 2^(octave+1)".
+func thresholdBound[Decimal Operator[Decimal]](
+	radius Decimal,
+	octave, bucket int,
+	places uint64,
+	newFromInt func(n uint64) (Decimal, error),
+) (Decimal, error) {
+	var zero Decimal
+
+	numerator, err := newFromInt(uint64(thresholdBucketsPerOctave + bucket))
+	if err != nil {
+		return zero, fmt.Errorf("creating bucket numerator: %w", err)
+	}
+
+	denominator, err := newFromInt(uint64(thresholdBucketsPerOctave) << (octave + 1))
+	if err != nil {
+		return zero, fmt.Errorf("creating bucket denominator: %w", err)
+	}
+
+	bound, err := radius.Mul(numerator)
+	if err != nil {
+		return zero, fmt.Errorf("multiplying radius by bucket numerator: %w", err)
+	}
+
+	bound, err = bound.DivRound(denominator, places)
+	if err != nil {
+		return zero, fmt.Errorf("dividing radius by bucket denominator: %w", err)
+	}
+
+	return bound, nil
+}
+
+// thresholdTerms returns the first "n" where "|c_n| * bound^n <= maxError", or zero if there are not enough terms.
+// The last term is never used, like in the adaptive evaluation.
+func thresholdTerms[Decimal Operator[Decimal]](bound Decimal, absTerms []Decimal, maxError, ulp Decimal, places uint64) (int, error) {
+	var pow Decimal
+
+	for n := 1; n < len(absTerms); n++ {
+		var err error
+
+		if n == 1 {
+			pow = bound
+		} else {
+			// Truncating and adding an ulp keeps "pow >= bound^n", without growing the number of digits.
+			pow, err = pow.Mul(bound)
+			if err != nil {
+				return 0, fmt.Errorf("computing bound^%d: %w", n, err)
+			}
+
+			pow, err = pow.Truncate(places)
+			if err != nil {
+				return 0, fmt.Errorf("truncating bound^%d: %w", n, err)
+			}
+
+			pow, err = pow.Add(ulp)
+			if err != nil {
+				return 0, fmt.Errorf("rounding up bound^%d: %w", n, err)
+			}
+		}
+
+		termBound, err := absTerms[n-1].Mul(pow)
+		if err != nil {
+			return 0, fmt.Errorf("computing taylor term %d bound: %w", n, err)
+		}
+
+		ok, err := termBound.LessThanOrEqual(maxError)
+		if err != nil {
+			return 0, fmt.Errorf("comparing taylor term %d bound with max error: %w", n, err)
+		}
+
+		if ok {
+			return n, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// lookup returns the number of terms for the bucket of the provided |rate|, or zero if it requires the adaptive evaluation.
+func (t *termThresholds[Decimal]) lookup(absRate Decimal) (int, error) {
+	lo, hi := 0, len(t.bounds)
+
+	// Binary search for the first bound greater than or equal to absRate.
+	for lo < hi {
+		mid := (lo + hi) / 2
+
+		ok, err := absRate.LessThanOrEqual(t.bounds[mid])
+		if err != nil {
+			return 0, fmt.Errorf("comparing |rate| with bucket bound: %w", err)
+		}
+
+		if ok {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	if lo == len(t.bounds) {
+		return 0, nil
+	}
+
+	return t.terms[lo], nil
+}
+
+// clone returns a copy of the thresholds, cloning every decimal.
+func (t *termThresholds[Decimal]) clone() *termThresholds[Decimal] {
+	if t == nil {
+		return nil
+	}
+
+	return &termThresholds[Decimal]{
+		bounds: cloneDecimals(t.bounds),
+		terms:  t.terms,
+	}
+}
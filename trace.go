@@ -0,0 +1,273 @@
+package tsratecalc
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Trace records how a rate was computed, term by term, so a result could be explained (e.g. in an audit).
+type Trace[Decimal Operator[Decimal]] struct {
+	// Root is the "n" in the formula: "(1+x)^(1/n)-1".
+	Root uint64
+	// Rate is the computed rate.
+	Rate Decimal
+	// Places is the number of decimal places of the result.
+	Places uint64
+	// MaxError is the maximum error accepted for the last term.
+	MaxError Decimal
+	// FixedTerms is the number of terms precomputed by eager caches for the |rate| interval.
+	// It's zero if the number of terms was decided by the error of each term (e.g. lazy caches).
+	FixedTerms int
+	// Evaluation is the scheme used to evaluate the fixed number of terms. The Steps always show the power form,
+	// which gives the same result for decimals with exact multiplication and addition.
+	Evaluation Evaluation
+	// Steps are the evaluated Taylor terms.
+	Steps []TraceStep[Decimal]
+	// Result is the computed result, the same returned by ComputeRateWithPrecision.
+	Result Decimal
+}
+
+// TraceStep records the evaluation of a single Taylor term.
+type TraceStep[Decimal Operator[Decimal]] struct {
+	// Term is the index of the Taylor term, starting at 1.
+	Term uint64
+	// Coefficient is the constant part of the Taylor term.
+	Coefficient Decimal
+	// Power is "rate^Term".
+	Power Decimal
+	// Value is "Coefficient * Power".
+	Value Decimal
+	// Sum is the sum of the values so far.
+	Sum Decimal
+	// Error is the absolute value of Value, the approximation error of the previous Sum.
+	Error Decimal
+	// Stop is true for the last term, when the Error is lower than or equal to MaxError,
+	// or when the FixedTerms are reached.
+	Stop bool
+}
+
+// ComputeRateTrace is the same as ComputeRate, but it also returns the Trace of the computation.
+// See ComputeRateWithPrecisionTrace for details.
+func (c *Calculator[Decimal]) ComputeRateTrace(rate Decimal) (Decimal, *Trace[Decimal], error) {
+	return c.ComputeRateWithPrecisionTrace(rate, c.precision)
+}
+
+// ComputeRateWithPrecisionTrace is the same as ComputeRateWithPrecision, but it also returns the Trace of the computation.
+//
+// The trace replays the evaluation term by term, so it's slower than ComputeRateWithPrecision, which isn't affected by it.
+// If the computation fails, the trace of the terms evaluated so far is returned with the error.
+func (c *Calculator[Decimal]) ComputeRateWithPrecisionTrace(rate Decimal, precision uint64) (Decimal, *Trace[Decimal], error) {
+	res, err := c.ComputeRateWithPrecision(rate, precision)
+	if err != nil {
+		// The trace shows the terms evaluated until the failure, if the rate could be evaluated at all.
+		trace, traceErr := c.trace(rate, precision)
+		if traceErr != nil {
+			return c.zero, nil, err
+		}
+
+		return c.zero, trace, err
+	}
+
+	trace, err := c.trace(rate, precision)
+	if err != nil {
+		return c.zero, nil, err
+	}
+
+	trace.Result = res
+
+	return res, trace, nil
+}
+
+// trace evaluates the Taylor terms one by one, recording each of them.
+func (c *Calculator[Decimal]) trace(rate Decimal, precision uint64) (*Trace[Decimal], error) {
+	if precision > c.precision {
+		return nil, fmt.Errorf("%w: configured precision is %d and requested precision is %d", ErrPrecisionAboveConfig, c.precision, precision)
+	}
+
+	places, err := c.resultPlaces(rate, precision)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.validateConvergence(rate)
+	if err != nil {
+		return nil, fmt.Errorf("validating boundaries: %w", err)
+	}
+
+	trace := &Trace[Decimal]{
+		Root:       c.root,
+		Rate:       rate,
+		Places:     places,
+		MaxError:   c.maxErrors[places],
+		Evaluation: c.evaluation,
+		Result:     c.zero,
+	}
+
+	// The same decision made by computeRate.
+	if c.thresholds != nil && places == c.precision {
+		absRate, err := rate.Abs()
+		if err != nil {
+			return nil, newOperatorError("Abs", "computing rate absolute value", 0, err)
+		}
+
+		trace.FixedTerms, err = c.thresholds.lookup(absRate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		sum   = c.zero
+		power = c.one
+
+		taylorTerms = c.taylorTerms.load()
+	)
+
+	for n := uint64(1); ; n++ {
+		if n >= uint64(len(taylorTerms)) {
+			taylorTerms, err = c.taylorTerms.grow(n + 1)
+			if err != nil {
+				return trace, err
+			}
+
+			if n >= uint64(len(taylorTerms)) {
+				return trace, nil
+			}
+		}
+
+		power, err = power.Mul(rate)
+		if err != nil {
+			return trace, newOperatorError("Mul", "computing rate^n", n, err)
+		}
+
+		value, err := taylorTerms[n-1].Mul(power)
+		if err != nil {
+			return trace, newOperatorError("Mul", "computing current taylor term", n, err)
+		}
+
+		sum, err = sum.Add(value)
+		if err != nil {
+			return trace, newOperatorError("Add", "adding current term to result", n, err)
+		}
+
+		absValue, err := value.Abs()
+		if err != nil {
+			return trace, newOperatorError("Abs", "computing taylor aproximation error absolute value", n, err)
+		}
+
+		var stop bool
+
+		if trace.FixedTerms > 0 {
+			stop = n == uint64(trace.FixedTerms)
+		} else {
+			stop, err = absValue.LessThanOrEqual(trace.MaxError)
+			if err != nil {
+				return trace, newOperatorError("LessThanOrEqual", "checking if current error is less than max error", n, err)
+			}
+		}
+
+		trace.Steps = append(trace.Steps, TraceStep[Decimal]{
+			Term:        n,
+			Coefficient: taylorTerms[n-1],
+			Power:       power,
+			Value:       value,
+			Sum:         sum,
+			Error:       absValue,
+			Stop:        stop,
+		})
+
+		if stop {
+			return trace, nil
+		}
+	}
+}
+
+// traceCSVHeader is the header of the CSV rendered by Trace.WriteCSV.
+var traceCSVHeader = []string{"term", "coefficient", "power", "value", "sum", "error", "stop"}
+
+// WriteCSV writes the trace steps as CSV, with a header row. The decimals are written with their String() representation.
+func (t *Trace[Decimal]) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	err := cw.Write(traceCSVHeader)
+	if err != nil {
+		return fmt.Errorf("writing trace csv header: %w", err)
+	}
+
+	for _, step := range t.Steps {
+		err = cw.Write([]string{
+			strconv.FormatUint(step.Term, 10),
+			step.Coefficient.String(),
+			step.Power.String(),
+			step.Value.String(),
+			step.Sum.String(),
+			step.Error.String(),
+			strconv.FormatBool(step.Stop),
+		})
+		if err != nil {
+			return fmt.Errorf("writing trace csv term %d: %w", step.Term, err)
+		}
+	}
+
+	cw.Flush()
+
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("flushing trace csv: %w", err)
+	}
+
+	return nil
+}
+
+// traceJSON is the JSON representation of a Trace. The decimals are represented with their String() representation.
+type traceJSON struct {
+	Root       uint64          `json:"root"`
+	Rate       string          `json:"rate"`
+	Places     uint64          `json:"places"`
+	MaxError   string          `json:"maxError"`
+	FixedTerms int             `json:"fixedTerms"`
+	Evaluation string          `json:"evaluation"`
+	Steps      []traceStepJSON `json:"steps"`
+	Result     string          `json:"result"`
+}
+
+// traceStepJSON is the JSON representation of a TraceStep.
+type traceStepJSON struct {
+	Term        uint64 `json:"term"`
+	Coefficient string `json:"coefficient"`
+	Power       string `json:"power"`
+	Value       string `json:"value"`
+	Sum         string `json:"sum"`
+	Error       string `json:"error"`
+	Stop        bool   `json:"stop"`
+}
+
+// MarshalJSON implements the json.Marshaler interface. The decimals are represented with their String() representation.
+func (t *Trace[Decimal]) MarshalJSON() ([]byte, error) {
+	v := traceJSON{
+		Root:       t.Root,
+		Rate:       t.Rate.String(),
+		Places:     t.Places,
+		MaxError:   t.MaxError.String(),
+		FixedTerms: t.FixedTerms,
+		Evaluation: t.Evaluation.String(),
+		Steps:      make([]traceStepJSON, 0, len(t.Steps)),
+		Result:     t.Result.String(),
+	}
+
+	for _, step := range t.Steps {
+		v.Steps = append(v.Steps, traceStepJSON{
+			Term:        step.Term,
+			Coefficient: step.Coefficient.String(),
+			Power:       step.Power.String(),
+			Value:       step.Value.String(),
+			Sum:         step.Sum.String(),
+			Error:       step.Error.String(),
+			Stop:        step.Stop,
+		})
+	}
+
+	return json.Marshal(v)
+}
@@ -0,0 +1,85 @@
+// Package tsratecalcfuzz provides a fuzz target to compare tsratecalc calculators against an exact reference.
+//
+// It's kept apart from tsratecalctest, so the conformance checks don't depend on the fuzzing library.
+package tsratecalcfuzz
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mqzabin/fuzzdecimal"
+
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/oracle"
+)
+
+// FuzzConfig describes the calculator under test for FuzzCalculator.
+type FuzzConfig[Decimal fmt.Stringer] struct {
+	// Root is the root configured in the calculator under test.
+	Root uint64
+	// Precision is the number of decimal places configured in the calculator under test.
+	Precision uint64
+	// Parse creates a Decimal from its string representation.
+	Parse func(s string) (Decimal, error)
+	// ComputeRate is the function under test, usually the calculator's ComputeRate method.
+	ComputeRate func(rate Decimal) (Decimal, error)
+}
+
+// FuzzCalculator fuzzes the provided ComputeRate function, comparing its results with the exact reference
+// computed by the oracle package.
+//
+// The result is accepted if it's closer than 10^(-precision) from the exact value of "(1+rate)^(1/root) - 1",
+// which is the maximum distance between two values truncated to the same number of decimal places.
+// Rates outside the convergence boundaries are skipped.
+//
+// The options are forwarded to fuzzdecimal.Fuzz, and should be used to restrict the generated rates.
+func FuzzCalculator[Decimal fmt.Stringer](f *testing.F, cfg FuzzConfig[Decimal], options ...fuzzdecimal.Option) {
+	f.Helper()
+
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Decimal, error) {
+		t.Helper()
+
+		return cfg.Parse(s)
+	}
+
+	fuzzdecimal.Fuzz(f, 1, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimal1(t, "ComputeRate", parseDecimal, func(t *fuzzdecimal.T, x1 Decimal) {
+			t.Helper()
+
+			res, err := cfg.ComputeRate(x1)
+			if errors.Is(err, tsratecalc.ErrRateOutsideConvergenceBoundaries) {
+				t.Skipf("rate '%s' is outside convergence boundaries", x1.String())
+			}
+
+			if err != nil {
+				t.Fatalf("ComputeRate: %v", err)
+			}
+
+			rate, err := oracle.ParseRat(x1.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := oracle.ParseRat(res.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ok, err := oracle.WithinULP(rate, got, cfg.Root, cfg.Precision)
+			if err != nil {
+				t.Fatalf("comparing with reference: %v", err)
+			}
+
+			if !ok {
+				want, err := oracle.Rate(rate, cfg.Root, cfg.Precision)
+				if err != nil {
+					t.Fatalf("computing reference: %v", err)
+				}
+
+				t.Errorf("unexpected result for rate '%s':\n\tgot: \n\t\t%s\n\twant: \n\t\t%s",
+					x1.String(), res.String(), want.FloatString(int(cfg.Precision)))
+			}
+		})
+	}, options...)
+}
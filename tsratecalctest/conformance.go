@@ -0,0 +1,364 @@
+// Package tsratecalctest provides utilities to validate tsratecalc.Operator adapters.
+//
+// Adapters should call RunOperatorConformance from their own tests, and could use tsratecalcfuzz.FuzzCalculator
+// to compare the resulting calculator against an exact reference.
+package tsratecalctest
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/mqzabin/tsratecalc"
+	"github.com/mqzabin/tsratecalc/oracle"
+)
+
+// conformanceValues are the decimal values used to check the Operator laws.
+// They have few digits, so fixed precision decimals are also able to represent all the results exactly.
+var conformanceValues = []string{
+	"0",
+	"1",
+	"-1",
+	"2",
+	"0.5",
+	"-0.25",
+	"3.14159",
+	"-2.71828",
+	"0.000001",
+	"123456.789",
+}
+
+// RunOperatorConformance checks whether the Decimal type correctly implements the tsratecalc.Operator interface.
+//
+// The newFromInt function should be the same factory provided to tsratecalc.Config, and parse should create
+// a Decimal from its string representation (e.g. "-0.25").
+//
+// It checks the algebraic laws of the arithmetic operations, the DivRound and Truncate semantics, PowInt edge cases,
+// and whether errors returned by the Decimal operations are propagated by tsratecalc.Calculator.
+func RunOperatorConformance[Decimal tsratecalc.Operator[Decimal]](
+	t *testing.T,
+	newFromInt func(n uint64) (Decimal, error),
+	parse func(s string) (Decimal, error),
+) {
+	t.Helper()
+
+	values := make([]Decimal, 0, len(conformanceValues))
+
+	for _, s := range conformanceValues {
+		d, err := parse(s)
+		if err != nil {
+			t.Fatalf("parsing '%s': %v", s, err)
+		}
+
+		assertEqualRat(t, "parse", d, mustRat(t, s))
+
+		values = append(values, d)
+	}
+
+	t.Run("NewFromInt", func(t *testing.T) {
+		for _, n := range []uint64{0, 1, 2, 10, 252, 1 << 40} {
+			d, err := newFromInt(n)
+			if err != nil {
+				t.Fatalf("NewFromInt(%d): %v", n, err)
+			}
+
+			assertEqualRat(t, fmt.Sprintf("NewFromInt(%d)", n), d, new(big.Rat).SetUint64(n))
+		}
+	})
+
+	zero := mustNewFromInt(t, newFromInt, 0)
+	one := mustNewFromInt(t, newFromInt, 1)
+
+	t.Run("Add", func(t *testing.T) {
+		must := mustFunc[Decimal](t)
+
+		for _, x := range values {
+			assertEqualRat(t, "x+0", must(x.Add(zero)), ratOf(t, x))
+
+			for _, y := range values {
+				want := new(big.Rat).Add(ratOf(t, x), ratOf(t, y))
+
+				assertEqualRat(t, "x+y", must(x.Add(y)), want)
+				assertEqualRat(t, "y+x", must(y.Add(x)), want)
+
+				for _, z := range values {
+					left := must(must(x.Add(y)).Add(z))
+					right := must(x.Add(must(y.Add(z))))
+
+					assertEqualRat(t, "(x+y)+z", left, ratOf(t, right))
+				}
+			}
+		}
+	})
+
+	t.Run("Sub", func(t *testing.T) {
+		must := mustFunc[Decimal](t)
+
+		for _, x := range values {
+			assertEqualRat(t, "x-x", must(x.Sub(x)), new(big.Rat))
+			assertEqualRat(t, "x-0", must(x.Sub(zero)), ratOf(t, x))
+
+			for _, y := range values {
+				want := new(big.Rat).Sub(ratOf(t, x), ratOf(t, y))
+
+				assertEqualRat(t, "x-y", must(x.Sub(y)), want)
+				assertEqualRat(t, "(x-y)+y", must(must(x.Sub(y)).Add(y)), ratOf(t, x))
+			}
+		}
+	})
+
+	t.Run("Mul", func(t *testing.T) {
+		must := mustFunc[Decimal](t)
+
+		for _, x := range values {
+			assertEqualRat(t, "x*1", must(x.Mul(one)), ratOf(t, x))
+			assertEqualRat(t, "x*0", must(x.Mul(zero)), new(big.Rat))
+
+			for _, y := range values {
+				want := new(big.Rat).Mul(ratOf(t, x), ratOf(t, y))
+
+				assertEqualRat(t, "x*y", must(x.Mul(y)), want)
+				assertEqualRat(t, "y*x", must(y.Mul(x)), want)
+
+				for _, z := range values {
+					left := must(x.Mul(must(y.Add(z))))
+					right := must(must(x.Mul(y)).Add(must(x.Mul(z))))
+
+					assertEqualRat(t, "x*(y+z)", left, ratOf(t, right))
+				}
+			}
+		}
+	})
+
+	t.Run("Abs", func(t *testing.T) {
+		must := mustFunc[Decimal](t)
+
+		for _, x := range values {
+			want := new(big.Rat).Abs(ratOf(t, x))
+
+			assertEqualRat(t, "|x|", must(x.Abs()), want)
+			assertEqualRat(t, "|-x|", must(must(zero.Sub(x)).Abs()), want)
+		}
+	})
+
+	t.Run("LessThanOrEqual", func(t *testing.T) {
+		for _, x := range values {
+			for _, y := range values {
+				got, err := x.LessThanOrEqual(y)
+				if err != nil {
+					t.Fatalf("LessThanOrEqual: %v", err)
+				}
+
+				if want := ratOf(t, x).Cmp(ratOf(t, y)) <= 0; got != want {
+					t.Errorf("'%s' <= '%s': got %t, want %t", x.String(), y.String(), got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("DivRound", func(t *testing.T) {
+		must := mustFunc[Decimal](t)
+
+		for _, x := range values {
+			for _, y := range values {
+				if ratOf(t, y).Sign() == 0 {
+					continue
+				}
+
+				exact := new(big.Rat).Quo(ratOf(t, x), ratOf(t, y))
+
+				for places := uint64(0); places <= 12; places++ {
+					got := must(x.DivRound(y, places))
+
+					assertPlaces(t, fmt.Sprintf("'%s'/'%s' at %d places", x.String(), y.String(), places), got, places)
+
+					// |got - exact| <= 10^(-places)/2
+					diff := new(big.Rat).Sub(ratOf(t, got), exact)
+					diff.Abs(diff)
+
+					maxDiff := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Mul(big.NewInt(2), pow10(places)))
+
+					if diff.Cmp(maxDiff) > 0 {
+						t.Errorf("'%s'/'%s' at %d places: got '%s', which is not rounded from '%s'",
+							x.String(), y.String(), places, got.String(), exact.FloatString(int(places)+5))
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("Truncate", func(t *testing.T) {
+		must := mustFunc[Decimal](t)
+
+		for _, x := range values {
+			for places := uint64(0); places <= 8; places++ {
+				got := must(x.Truncate(places))
+
+				// Truncation is towards zero.
+				scale := pow10(places)
+				num := new(big.Int).Mul(ratOf(t, x).Num(), scale)
+				num.Quo(num, ratOf(t, x).Denom())
+
+				assertEqualRat(t, fmt.Sprintf("truncate '%s' at %d places", x.String(), places), got, new(big.Rat).SetFrac(num, scale))
+			}
+		}
+	})
+
+	t.Run("PowInt", func(t *testing.T) {
+		must := mustFunc[Decimal](t)
+		ten := mustNewFromInt(t, newFromInt, 10)
+
+		for _, x := range values {
+			if ratOf(t, x).Sign() != 0 {
+				assertEqualRat(t, "x^0", must(x.PowInt(0)), big.NewRat(1, 1))
+			}
+
+			assertEqualRat(t, "x^1", must(x.PowInt(1)), ratOf(t, x))
+
+			want := new(big.Rat).Mul(ratOf(t, x), ratOf(t, x))
+			want.Mul(want, ratOf(t, x))
+
+			assertEqualRat(t, "x^3", must(x.PowInt(3)), want)
+		}
+
+		minusOne := must(zero.Sub(one))
+
+		for _, n := range []uint64{1, 2, 7, 30, 31, 252} {
+			assertEqualRat(t, "0^n", must(zero.PowInt(n)), new(big.Rat))
+			assertEqualRat(t, "1^n", must(one.PowInt(n)), big.NewRat(1, 1))
+			assertEqualRat(t, "10^n", must(ten.PowInt(n)), new(big.Rat).SetInt(pow10(n)))
+
+			want := big.NewRat(1, 1)
+			if n%2 == 1 {
+				want = big.NewRat(-1, 1)
+			}
+
+			assertEqualRat(t, "(-1)^n", must(minusOne.PowInt(n)), want)
+		}
+	})
+
+	t.Run("ErrorPropagation", func(t *testing.T) {
+		runErrorPropagation(t, newFromInt, parse)
+	})
+}
+
+// runErrorPropagation checks that a failure on any Decimal operation is reported by the Calculator.
+func runErrorPropagation[Decimal tsratecalc.Operator[Decimal]](
+	t *testing.T,
+	newFromInt func(n uint64) (Decimal, error),
+	parse func(s string) (Decimal, error),
+) {
+	t.Helper()
+
+	radius, err := parse("0.5")
+	if err != nil {
+		t.Fatalf("parsing radius: %v", err)
+	}
+
+	rate, err := parse("0.1")
+	if err != nil {
+		t.Fatalf("parsing rate: %v", err)
+	}
+
+	for _, op := range faultyOperations {
+		t.Run(op, func(t *testing.T) {
+			fault := &faultInjector{op: op}
+
+			faultyNewFromInt := func(n uint64) (faultyDecimal[Decimal], error) {
+				if err := fault.check("NewFromInt"); err != nil {
+					return faultyDecimal[Decimal]{}, err
+				}
+
+				d, err := newFromInt(n)
+
+				return faultyDecimal[Decimal]{d: d, fault: fault}, err
+			}
+
+			cfg := tsratecalc.Config[faultyDecimal[Decimal]]{
+				Root:              252,
+				Precision:         10,
+				NewFromInt:        faultyNewFromInt,
+				ConvergenceRadius: faultyDecimal[Decimal]{d: radius, fault: fault},
+			}
+
+			calc, err := tsratecalc.NewCalculator(cfg)
+			if err == nil {
+				_, err = calc.ComputeRate(faultyDecimal[Decimal]{d: rate, fault: fault})
+			}
+
+			if !fault.triggered {
+				t.Skipf("%s is not used by the calculator", op)
+			}
+
+			if !errors.Is(err, errInjected) {
+				t.Fatalf("injected %s failure was not propagated, got error: %v", op, err)
+			}
+		})
+	}
+}
+
+func mustRat(t *testing.T, s string) *big.Rat {
+	t.Helper()
+
+	r, err := oracle.ParseRat(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r
+}
+
+func ratOf(t *testing.T, d fmt.Stringer) *big.Rat {
+	t.Helper()
+
+	return mustRat(t, d.String())
+}
+
+// mustFunc returns a function that fails the test if an Operator method returns an error.
+func mustFunc[Decimal any](t *testing.T) func(d Decimal, err error) Decimal {
+	t.Helper()
+
+	return func(d Decimal, err error) Decimal {
+		t.Helper()
+
+		if err != nil {
+			t.Fatalf("unexpected operation error: %v", err)
+		}
+
+		return d
+	}
+}
+
+func mustNewFromInt[Decimal any](t *testing.T, newFromInt func(n uint64) (Decimal, error), n uint64) Decimal {
+	t.Helper()
+
+	d, err := newFromInt(n)
+	if err != nil {
+		t.Fatalf("NewFromInt(%d): %v", n, err)
+	}
+
+	return d
+}
+
+func assertEqualRat(t *testing.T, name string, got fmt.Stringer, want *big.Rat) {
+	t.Helper()
+
+	if ratOf(t, got).Cmp(want) != 0 {
+		t.Errorf("%s: got '%s', want '%s'", name, got.String(), want.RatString())
+	}
+}
+
+func assertPlaces(t *testing.T, name string, got fmt.Stringer, places uint64) {
+	t.Helper()
+
+	scaled := new(big.Rat).Mul(ratOf(t, got), new(big.Rat).SetInt(pow10(places)))
+	if !scaled.IsInt() {
+		t.Errorf("%s: '%s' has more than %d decimal places", name, got.String(), places)
+	}
+}
+
+// pow10 returns 10^n as an integer.
+func pow10(n uint64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(n), nil)
+}
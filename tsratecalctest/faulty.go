@@ -0,0 +1,115 @@
+package tsratecalctest
+
+import (
+	"errors"
+
+	"github.com/mqzabin/tsratecalc"
+)
+
+var errInjected = errors.New("injected failure")
+
+// faultyOperations are the operations that faultyDecimal is able to fail.
+var faultyOperations = []string{
+	"NewFromInt",
+	"Mul",
+	"DivRound",
+	"Sub",
+	"Add",
+	"Abs",
+	"LessThanOrEqual",
+	"PowInt",
+	"Truncate",
+}
+
+// faultInjector fails the first call to a specific operation.
+type faultInjector struct {
+	op        string
+	triggered bool
+}
+
+func (f *faultInjector) check(op string) error {
+	if f.triggered || f.op != op {
+		return nil
+	}
+
+	f.triggered = true
+
+	return errInjected
+}
+
+// faultyDecimal wraps a Decimal, returning errInjected on the first call to the operation configured in faultInjector.
+type faultyDecimal[Decimal tsratecalc.Operator[Decimal]] struct {
+	d     Decimal
+	fault *faultInjector
+}
+
+func (f faultyDecimal[Decimal]) wrap(op string, d Decimal, err error) (faultyDecimal[Decimal], error) {
+	if err != nil {
+		return faultyDecimal[Decimal]{}, err
+	}
+
+	if err := f.fault.check(op); err != nil {
+		return faultyDecimal[Decimal]{}, err
+	}
+
+	return faultyDecimal[Decimal]{d: d, fault: f.fault}, nil
+}
+
+func (f faultyDecimal[Decimal]) Mul(n faultyDecimal[Decimal]) (faultyDecimal[Decimal], error) {
+	d, err := f.d.Mul(n.d)
+
+	return f.wrap("Mul", d, err)
+}
+
+func (f faultyDecimal[Decimal]) DivRound(n faultyDecimal[Decimal], places uint64) (faultyDecimal[Decimal], error) {
+	d, err := f.d.DivRound(n.d, places)
+
+	return f.wrap("DivRound", d, err)
+}
+
+func (f faultyDecimal[Decimal]) Sub(n faultyDecimal[Decimal]) (faultyDecimal[Decimal], error) {
+	d, err := f.d.Sub(n.d)
+
+	return f.wrap("Sub", d, err)
+}
+
+func (f faultyDecimal[Decimal]) Add(n faultyDecimal[Decimal]) (faultyDecimal[Decimal], error) {
+	d, err := f.d.Add(n.d)
+
+	return f.wrap("Add", d, err)
+}
+
+func (f faultyDecimal[Decimal]) Abs() (faultyDecimal[Decimal], error) {
+	d, err := f.d.Abs()
+
+	return f.wrap("Abs", d, err)
+}
+
+func (f faultyDecimal[Decimal]) LessThanOrEqual(n faultyDecimal[Decimal]) (bool, error) {
+	b, err := f.d.LessThanOrEqual(n.d)
+	if err != nil {
+		return false, err
+	}
+
+	if err := f.fault.check("LessThanOrEqual"); err != nil {
+		return false, err
+	}
+
+	return b, nil
+}
+
+func (f faultyDecimal[Decimal]) PowInt(n uint64) (faultyDecimal[Decimal], error) {
+	d, err := f.d.PowInt(n)
+
+	return f.wrap("PowInt", d, err)
+}
+
+func (f faultyDecimal[Decimal]) Truncate(places uint64) (faultyDecimal[Decimal], error) {
+	d, err := f.d.Truncate(places)
+
+	return f.wrap("Truncate", d, err)
+}
+
+func (f faultyDecimal[Decimal]) String() string {
+	return f.d.String()
+}
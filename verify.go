@@ -0,0 +1,195 @@
+package tsratecalc
+
+import (
+	"fmt"
+
+	"github.com/mqzabin/tsratecalc/oracle"
+)
+
+// Verify computes the rate exactly like ComputeRate, then cross-checks the result against the exact reference
+// computed by the oracle package.
+//
+// The result is accepted if it's closer than 10^(-precision) from the exact value of "(1+rate)^(1/root) - 1",
+// otherwise ReferenceMismatchError will be returned.
+//
+// The exact reference is much slower than ComputeRate, so Verify is meant for audits and tests.
+func (c *Calculator[Decimal]) Verify(rate Decimal) (Decimal, error) {
+	res, err := c.ComputeRate(rate)
+	if err != nil {
+		return c.zero, err
+	}
+
+	rateRat, err := oracle.ParseRat(rate.String())
+	if err != nil {
+		return c.zero, fmt.Errorf("parsing rate: %w", err)
+	}
+
+	resRat, err := oracle.ParseRat(res.String())
+	if err != nil {
+		return c.zero, fmt.Errorf("parsing result: %w", err)
+	}
+
+	places, err := c.resultPlaces(rate, c.precision)
+	if err != nil {
+		return c.zero, err
+	}
+
+	ok, err := oracle.WithinULP(rateRat, resRat, c.root, places)
+	if err != nil {
+		return c.zero, fmt.Errorf("comparing result with reference: %w", err)
+	}
+
+	if ok {
+		return res, nil
+	}
+
+	reference, err := oracle.Rate(rateRat, c.root, places)
+	if err != nil {
+		return c.zero, fmt.Errorf("computing reference: %w", err)
+	}
+
+	return c.zero, &ReferenceMismatchError[Decimal]{
+		Root:      c.root,
+		Precision: places,
+		Rate:      rate,
+		Result:    res,
+		Reference: reference.FloatString(int(places)),
+	}
+}
+
+// verifyGuardDigits is the number of extra decimal places of "(1+result)^root", on top of the result precision.
+const verifyGuardDigits = 10
+
+// verifyResult checks if "(1+result)^root" is close enough to "1+rate".
+//
+// Since the result is closer than 10^(-precision) from the exact value, the difference is bounded by
+// "2*root*10^(-precision)*max(1, 1+rate)". The power is computed with verifyGuardDigits extra decimal places,
+// so its own rounding is negligible against that bound, whatever the Decimal type precision.
+func (c *Calculator[Decimal]) verifyResult(rate, result Decimal, precision uint64) error {
+	expected, err := c.one.Add(rate)
+	if err != nil {
+		return newOperatorError("Add", "computing 1+rate", 0, err)
+	}
+
+	power, err := c.one.Add(result)
+	if err != nil {
+		return newOperatorError("Add", "computing 1+result", 0, err)
+	}
+
+	power, err = powTruncated(c.one, power, c.root, precision+verifyGuardDigits)
+	if err != nil {
+		return err
+	}
+
+	diff, err := power.Sub(expected)
+	if err != nil {
+		return newOperatorError("Sub", "computing verification difference", 0, err)
+	}
+
+	diff, err = diff.Abs()
+	if err != nil {
+		return newOperatorError("Abs", "computing verification difference absolute value", 0, err)
+	}
+
+	tolerance := c.verifyTolerances[precision]
+
+	positive, err := c.zero.LessThanOrEqual(rate)
+	if err != nil {
+		return newOperatorError("LessThanOrEqual", "checking if rate is positive", 0, err)
+	}
+
+	if positive {
+		tolerance, err = tolerance.Mul(expected)
+		if err != nil {
+			return newOperatorError("Mul", "scaling verification tolerance", 0, err)
+		}
+	}
+
+	ok, err := diff.LessThanOrEqual(tolerance)
+	if err != nil {
+		return newOperatorError("LessThanOrEqual", "comparing verification difference with tolerance", 0, err)
+	}
+
+	if ok {
+		return nil
+	}
+
+	return &VerificationError[Decimal]{
+		Root:      c.root,
+		Precision: precision,
+		Rate:      rate,
+		Result:    result,
+		Expected:  expected,
+		Power:     power,
+		Tolerance: tolerance,
+	}
+}
+
+// powTruncated returns "base^n" for a non-negative base, by repeated squaring, truncating every product
+// to the provided number of decimal places. Each truncation lowers the power by less than 10^(-places)
+// times the remaining exponent, so the digits of the result aren't multiplied by the exponent.
+func powTruncated[Decimal Operator[Decimal]](one, base Decimal, n, places uint64) (Decimal, error) {
+	res := one
+
+	for n > 0 {
+		var err error
+
+		if n&1 == 1 {
+			res, err = res.Mul(base)
+			if err != nil {
+				return res, newOperatorError("Mul", "multiplying power by base", 0, err)
+			}
+
+			res, err = res.Truncate(places)
+			if err != nil {
+				return res, newOperatorError("Truncate", "truncating power", 0, err)
+			}
+		}
+
+		n >>= 1
+
+		if n > 0 {
+			base, err = base.Mul(base)
+			if err != nil {
+				return res, newOperatorError("Mul", "squaring base", 0, err)
+			}
+
+			base, err = base.Truncate(places)
+			if err != nil {
+				return res, newOperatorError("Truncate", "truncating squared base", 0, err)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// computeVerifyTolerance returns 2*root*10^(-precision), given maxError as 10^(-precision)/2.
+func computeVerifyTolerance[Decimal Operator[Decimal]](
+	root Decimal,
+	maxError Decimal,
+	newFromInt func(n uint64) (Decimal, error),
+) (Decimal, error) {
+	four, err := newFromInt(4)
+	if err != nil {
+		var zero Decimal
+
+		return zero, fmt.Errorf("creating '4' decimal: %w", err)
+	}
+
+	tolerance, err := four.Mul(root)
+	if err != nil {
+		var zero Decimal
+
+		return zero, fmt.Errorf("multiplying root by 4: %w", err)
+	}
+
+	tolerance, err = tolerance.Mul(maxError)
+	if err != nil {
+		var zero Decimal
+
+		return zero, fmt.Errorf("multiplying max error by 4*root: %w", err)
+	}
+
+	return tolerance, nil
+}